@@ -35,6 +35,7 @@ import (
 	ast "github.com/craterdog/go-grammar-framework/v4/ast"
 	gen "github.com/craterdog/go-grammar-framework/v4/generator"
 	gra "github.com/craterdog/go-grammar-framework/v4/grammar"
+	fsx "io/fs"
 )
 
 // TYPE ALIASES
@@ -47,6 +48,7 @@ type (
 	CharacterLike   = ast.CharacterLike
 	ConstrainedLike = ast.ConstrainedLike
 	DefinitionLike  = ast.DefinitionLike
+	DirectiveLike   = ast.DirectiveLike
 	ElementLike     = ast.ElementLike
 	ExplicitLike    = ast.ExplicitLike
 	ExpressionLike  = ast.ExpressionLike
@@ -54,51 +56,74 @@ type (
 	FilterLike      = ast.FilterLike
 	GroupLike       = ast.GroupLike
 	IdentifierLike  = ast.IdentifierLike
+	ImportLike      = ast.ImportLike
 	InlineLike      = ast.InlineLike
+	LabelLike       = ast.LabelLike
+	LevelLike       = ast.LevelLike
 	LimitLike       = ast.LimitLike
 	LineLike        = ast.LineLike
+	LookaheadLike   = ast.LookaheadLike
+	ModeLike        = ast.ModeLike
 	MultilineLike   = ast.MultilineLike
 	NoticeLike      = ast.NoticeLike
+	OperatorLike    = ast.OperatorLike
 	OptionLike      = ast.OptionLike
 	PatternLike     = ast.PatternLike
+	PositionLike    = ast.PositionLike
+	PrecedenceLike  = ast.PrecedenceLike
 	QuantifiedLike  = ast.QuantifiedLike
 	ReferenceLike   = ast.ReferenceLike
 	RepetitionLike  = ast.RepetitionLike
 	RuleLike        = ast.RuleLike
+	SeparatorLike   = ast.SeparatorLike
+	SpanLike        = ast.SpanLike
 	SyntaxLike      = ast.SyntaxLike
 	TermLike        = ast.TermLike
 	TextLike        = ast.TextLike
+	TransitionLike  = ast.TransitionLike
 )
 
 // Grammar
 
 type (
-	FormatterLike = gra.FormatterLike
-	ParserLike    = gra.ParserLike
-	ProcessorLike = gra.ProcessorLike
-	ScannerLike   = gra.ScannerLike
-	TokenType     = gra.TokenType
-	ValidatorLike = gra.ValidatorLike
-	VisitorLike   = gra.VisitorLike
-	Methodical    = gra.Methodical
+	FormatterLike  = gra.FormatterLike
+	ParseErrorLike = gra.ParseErrorLike
+	ParserLike     = gra.ParserLike
+	ProcessorLike  = gra.ProcessorLike
+	ScannerLike    = gra.ScannerLike
+	TokenLike      = gra.TokenLike
+	TokenType      = gra.TokenType
+	ValidatorLike  = gra.ValidatorLike
+	VisitorLike    = gra.VisitorLike
+	Methodical     = gra.Methodical
+)
+
+// Generator
+
+type (
+	TemplatesLike = gen.TemplatesLike
 )
 
 const (
-	ErrorToken     = gra.ErrorToken
-	CommentToken   = gra.CommentToken
-	DelimiterToken = gra.DelimiterToken
-	ExcludedToken  = gra.ExcludedToken
-	GlyphToken     = gra.GlyphToken
-	IntrinsicToken = gra.IntrinsicToken
-	LiteralToken   = gra.LiteralToken
-	LowercaseToken = gra.LowercaseToken
-	NewlineToken   = gra.NewlineToken
-	NoteToken      = gra.NoteToken
-	NumberToken    = gra.NumberToken
-	OptionalToken  = gra.OptionalToken
-	RepeatedToken  = gra.RepeatedToken
-	SpaceToken     = gra.SpaceToken
-	UppercaseToken = gra.UppercaseToken
+	ErrorToken         = gra.ErrorToken
+	AssociativityToken = gra.AssociativityToken
+	CaselessToken      = gra.CaselessToken
+	CommentToken       = gra.CommentToken
+	DelimiterToken     = gra.DelimiterToken
+	ExcludedToken      = gra.ExcludedToken
+	GlyphToken         = gra.GlyphToken
+	IntrinsicToken     = gra.IntrinsicToken
+	KeywordToken       = gra.KeywordToken
+	LiteralToken       = gra.LiteralToken
+	LowercaseToken     = gra.LowercaseToken
+	NewlineToken       = gra.NewlineToken
+	NoteToken          = gra.NoteToken
+	NumberToken        = gra.NumberToken
+	OptionalToken      = gra.OptionalToken
+	PredicateToken     = gra.PredicateToken
+	RepeatedToken      = gra.RepeatedToken
+	SpaceToken         = gra.SpaceToken
+	UppercaseToken     = gra.UppercaseToken
 )
 
 // UNIVERSAL CONSTRUCTORS
@@ -243,6 +268,7 @@ func Definition(arguments ...any) DefinitionLike {
 	// Initialize the possible arguments.
 	var inline InlineLike
 	var multiline MultilineLike
+	var precedence PrecedenceLike
 
 	// Process the actual arguments.
 	for _, argument := range arguments {
@@ -251,6 +277,8 @@ func Definition(arguments ...any) DefinitionLike {
 			inline = actual
 		case MultilineLike:
 			multiline = actual
+		case PrecedenceLike:
+			precedence = actual
 		default:
 			var message = fmt.Sprintf(
 				"An unknown argument type passed into the definition constructor: %T\n",
@@ -267,12 +295,54 @@ func Definition(arguments ...any) DefinitionLike {
 		definition = ast.Definition().Make(inline)
 	case col.IsDefined(multiline):
 		definition = ast.Definition().Make(multiline)
+	case col.IsDefined(precedence):
+		definition = ast.Definition().Make(precedence)
 	default:
 		panic("The constructor for a definition requires an argument.")
 	}
 	return definition
 }
 
+func Directive(arguments ...any) DirectiveLike {
+	// Initialize the possible arguments.
+	var lowercase string
+	var note string
+
+	// Process the actual arguments.
+	for _, argument := range arguments {
+		switch actual := argument.(type) {
+		case string:
+			switch {
+			case MatchesType(actual, LowercaseToken):
+				lowercase = actual
+			case MatchesType(actual, NoteToken):
+				note = actual
+			default:
+				var message = fmt.Sprintf(
+					"An invalid string was passed into the directive constructor: %q\n",
+					actual,
+				)
+				panic(message)
+			}
+		default:
+			var message = fmt.Sprintf(
+				"An unknown argument type passed into the directive constructor: %T\n",
+				actual,
+			)
+			panic(message)
+		}
+	}
+
+	// Call the constructor.
+	var newlines = col.List[string]([]string{"\n"})
+	var directive = ast.Directive().Make(
+		lowercase,
+		note,
+		newlines,
+	)
+	return directive
+}
+
 func Element(arguments ...any) ElementLike {
 	// Initialize the possible arguments.
 	var group GroupLike
@@ -353,7 +423,9 @@ func Explicit(arguments ...any) ExplicitLike {
 func Expression(arguments ...any) ExpressionLike {
 	// Initialize the possible arguments.
 	var lowercase string
+	var caseless string
 	var pattern PatternLike
+	var transition TransitionLike
 	var note string
 
 	// Process the actual arguments.
@@ -363,6 +435,8 @@ func Expression(arguments ...any) ExpressionLike {
 			switch {
 			case MatchesType(actual, LowercaseToken):
 				lowercase = actual
+			case MatchesType(actual, CaselessToken):
+				caseless = actual
 			case MatchesType(actual, NoteToken):
 				note = actual
 			default:
@@ -374,6 +448,8 @@ func Expression(arguments ...any) ExpressionLike {
 			}
 		case PatternLike:
 			pattern = actual
+		case TransitionLike:
+			transition = actual
 		default:
 			var message = fmt.Sprintf(
 				"An unknown argument type passed into the expression constructor: %T\n",
@@ -387,7 +463,9 @@ func Expression(arguments ...any) ExpressionLike {
 	var newlines = col.List[string]([]string{"\n", "\n"})
 	var expression = ast.Expression().Make(
 		lowercase,
+		caseless,
 		pattern,
+		transition,
 		note,
 		newlines,
 	)
@@ -523,6 +601,46 @@ func Identifier(arguments ...any) IdentifierLike {
 	return identifier
 }
 
+func Import(arguments ...any) ImportLike {
+	// Initialize the possible arguments.
+	var literal string
+	var note string
+
+	// Process the actual arguments.
+	for _, argument := range arguments {
+		switch actual := argument.(type) {
+		case string:
+			switch {
+			case MatchesType(actual, LiteralToken):
+				literal = actual
+			case MatchesType(actual, NoteToken):
+				note = actual
+			default:
+				var message = fmt.Sprintf(
+					"An invalid string was passed into the import constructor: %q\n",
+					actual,
+				)
+				panic(message)
+			}
+		default:
+			var message = fmt.Sprintf(
+				"An unknown argument type passed into the import constructor: %T\n",
+				actual,
+			)
+			panic(message)
+		}
+	}
+
+	// Call the constructor.
+	var newlines = col.List[string]([]string{"\n"})
+	var import_ = ast.Import().Make(
+		literal,
+		note,
+		newlines,
+	)
+	return import_
+}
+
 func Inline(arguments ...any) InlineLike {
 	// Initialize the possible arguments.
 	var terms abs.Sequential[TermLike]
@@ -552,6 +670,82 @@ func Inline(arguments ...any) InlineLike {
 	return inline
 }
 
+func Label(arguments ...any) LabelLike {
+	// Initialize the possible arguments.
+	var lowercase string
+
+	// Process the actual arguments.
+	for _, argument := range arguments {
+		switch actual := argument.(type) {
+		case string:
+			switch {
+			case MatchesType(actual, LowercaseToken):
+				lowercase = actual
+			default:
+				var message = fmt.Sprintf(
+					"An unknown argument value was passed into the label constructor: %q\n",
+					actual,
+				)
+				panic(message)
+			}
+		default:
+			var message = fmt.Sprintf(
+				"An unknown argument type passed into the label constructor: %T\n",
+				actual,
+			)
+			panic(message)
+		}
+	}
+
+	// Call the constructor.
+	var label = ast.Label().Make(lowercase)
+	return label
+}
+
+func Level(arguments ...any) LevelLike {
+	// Initialize the possible arguments.
+	var associativity string
+	var operators abs.Sequential[OperatorLike]
+	var note string
+
+	// Process the actual arguments.
+	for _, argument := range arguments {
+		switch actual := argument.(type) {
+		case abs.Sequential[OperatorLike]:
+			operators = actual
+		case string:
+			switch {
+			case MatchesType(actual, AssociativityToken):
+				associativity = actual
+			case MatchesType(actual, NoteToken):
+				note = actual
+			default:
+				var message = fmt.Sprintf(
+					"An invalid string was passed into the level constructor: %q\n",
+					actual,
+				)
+				panic(message)
+			}
+		default:
+			var message = fmt.Sprintf(
+				"An unknown argument type passed into the level constructor: %T\n",
+				actual,
+			)
+			panic(message)
+		}
+	}
+
+	// Call the constructor.
+	var newline = "\n"
+	var level = ast.Level().Make(
+		associativity,
+		operators,
+		note,
+		newline,
+	)
+	return level
+}
+
 func Limit(arguments ...any) LimitLike {
 	// Initialize the possible arguments.
 	var number string
@@ -615,6 +809,88 @@ func Line(arguments ...any) LineLike {
 	return line
 }
 
+func Lookahead(arguments ...any) LookaheadLike {
+	// Initialize the possible arguments.
+	var predicate string
+	var term TermLike
+
+	// Process the actual arguments.
+	for _, argument := range arguments {
+		switch actual := argument.(type) {
+		case string:
+			switch {
+			case MatchesType(actual, PredicateToken):
+				predicate = actual
+			default:
+				var message = fmt.Sprintf(
+					"An invalid string was passed into the lookahead constructor: %q\n",
+					actual,
+				)
+				panic(message)
+			}
+		case TermLike:
+			term = actual
+		default:
+			var message = fmt.Sprintf(
+				"An unknown argument type passed into the lookahead constructor: %T\n",
+				actual,
+			)
+			panic(message)
+		}
+	}
+
+	// Call the constructor.
+	var lookahead = ast.Lookahead().Make(
+		predicate,
+		term,
+	)
+	return lookahead
+}
+
+func Mode(arguments ...any) ModeLike {
+	// Initialize the possible arguments.
+	var lowercase string
+	var note string
+	var expressions abs.Sequential[ExpressionLike]
+
+	// Process the actual arguments.
+	for _, argument := range arguments {
+		switch actual := argument.(type) {
+		case string:
+			switch {
+			case MatchesType(actual, LowercaseToken):
+				lowercase = actual
+			case MatchesType(actual, NoteToken):
+				note = actual
+			default:
+				var message = fmt.Sprintf(
+					"An invalid string was passed into the mode constructor: %q\n",
+					actual,
+				)
+				panic(message)
+			}
+		case abs.Sequential[ExpressionLike]:
+			expressions = actual
+		default:
+			var message = fmt.Sprintf(
+				"An unknown argument type passed into the mode constructor: %T\n",
+				actual,
+			)
+			panic(message)
+		}
+	}
+
+	// Call the constructor.
+	var newlines = col.List[string]([]string{"\n"})
+	var mode = ast.Mode().Make(
+		lowercase,
+		note,
+		newlines,
+		expressions,
+	)
+	return mode
+}
+
 func Multiline(arguments ...any) MultilineLike {
 	// Initialize the possible arguments.
 	var lines abs.Sequential[LineLike]
@@ -672,6 +948,38 @@ func Notice(arguments ...any) NoticeLike {
 	return notice
 }
 
+func Operator(arguments ...any) OperatorLike {
+	// Initialize the possible arguments.
+	var literal string
+
+	// Process the actual arguments.
+	for _, argument := range arguments {
+		switch actual := argument.(type) {
+		case string:
+			switch {
+			case MatchesType(actual, LiteralToken):
+				literal = actual
+			default:
+				var message = fmt.Sprintf(
+					"An invalid string was passed into the operator constructor: %q\n",
+					actual,
+				)
+				panic(message)
+			}
+		default:
+			var message = fmt.Sprintf(
+				"An unknown argument type passed into the operator constructor: %T\n",
+				actual,
+			)
+			panic(message)
+		}
+	}
+
+	// Call the constructor.
+	var operator = ast.Operator().Make(literal)
+	return operator
+}
+
 func Option(arguments ...any) OptionLike {
 	// Initialize the possible arguments.
 	var repetitions = col.List[RepetitionLike]()
@@ -731,6 +1039,41 @@ func Pattern(arguments ...any) PatternLike {
 	return pattern
 }
 
+func Precedence(arguments ...any) PrecedenceLike {
+	// Initialize the possible arguments.
+	var identifier IdentifierLike
+	var note string
+	var levels abs.Sequential[LevelLike]
+
+	// Process the actual arguments.
+	for _, argument := range arguments {
+		switch actual := argument.(type) {
+		case IdentifierLike:
+			identifier = actual
+		case string:
+			note = actual
+		case abs.Sequential[LevelLike]:
+			levels = actual
+		default:
+			var message = fmt.Sprintf(
+				"An unknown argument type passed into the precedence constructor: %T\n",
+				actual,
+			)
+			panic(message)
+		}
+	}
+
+	// Call the constructor.
+	var newline = "\n"
+	var precedence = ast.Precedence().Make(
+		identifier,
+		note,
+		newline,
+		levels,
+	)
+	return precedence
+}
+
 func Quantified(arguments ...any) QuantifiedLike {
 	// Initialize the possible arguments.
 	var number string
@@ -771,16 +1114,22 @@ func Quantified(arguments ...any) QuantifiedLike {
 
 func Reference(arguments ...any) ReferenceLike {
 	// Initialize the possible arguments.
+	var label LabelLike
 	var identifier IdentifierLike
 	var cardinality CardinalityLike
+	var separator SeparatorLike
 
 	// Process the actual arguments.
 	for _, argument := range arguments {
 		switch actual := argument.(type) {
+		case LabelLike:
+			label = actual
 		case IdentifierLike:
 			identifier = actual
 		case CardinalityLike:
 			cardinality = actual
+		case SeparatorLike:
+			separator = actual
 		default:
 			var message = fmt.Sprintf(
 				"An unknown argument type passed into the reference constructor: %T\n",
@@ -792,8 +1141,10 @@ func Reference(arguments ...any) ReferenceLike {
 
 	// Call the constructor.
 	var reference = ast.Reference().Make(
+		label,
 		identifier,
 		cardinality,
+		separator,
 	)
 	return reference
 }
@@ -867,28 +1218,79 @@ func Rule(arguments ...any) RuleLike {
 	return rule
 }
 
+func Separator(arguments ...any) SeparatorLike {
+	// Initialize the possible arguments.
+	var literal string
+	var optional string
+
+	// Process the actual arguments.
+	for _, argument := range arguments {
+		switch actual := argument.(type) {
+		case string:
+			switch {
+			case MatchesType(actual, LiteralToken):
+				literal = actual
+			case MatchesType(actual, OptionalToken):
+				optional = actual
+			default:
+				var message = fmt.Sprintf(
+					"An invalid string was passed into the separator constructor: %q\n",
+					actual,
+				)
+				panic(message)
+			}
+		default:
+			var message = fmt.Sprintf(
+				"An unknown argument type passed into the separator constructor: %T\n",
+				actual,
+			)
+			panic(message)
+		}
+	}
+
+	// Call the constructor.
+	var separator = ast.Separator().Make(
+		literal,
+		optional,
+	)
+	return separator
+}
+
 func Syntax(arguments ...any) SyntaxLike {
 	// Initialize the possible arguments.
 	var notice NoticeLike
-	var comment1 string
+	var directives abs.Sequential[DirectiveLike] = col.List[DirectiveLike]()
+	var imports abs.Sequential[ImportLike] = col.List[ImportLike]()
+	var ruleHeader string
+	var optionalNewline string
 	var rules abs.Sequential[RuleLike]
-	var comment2 string
+	var expressionHeader string
 	var expressions abs.Sequential[ExpressionLike]
+	var modes abs.Sequential[ModeLike] = col.List[ModeLike]()
 
 	// Process the actual arguments.
 	for _, argument := range arguments {
 		switch actual := argument.(type) {
 		case NoticeLike:
 			notice = actual
+		case abs.Sequential[DirectiveLike]:
+			directives = actual
+		case abs.Sequential[ImportLike]:
+			imports = actual
 		case abs.Sequential[RuleLike]:
 			rules = actual
 		case abs.Sequential[ExpressionLike]:
 			expressions = actual
+		case abs.Sequential[ModeLike]:
+			modes = actual
 		case string:
-			if col.IsUndefined(comment1) {
-				comment1 = actual
-			} else {
-				comment2 = actual
+			switch {
+			case actual == "\n":
+				optionalNewline = actual
+			case col.IsUndefined(ruleHeader):
+				ruleHeader = actual
+			default:
+				expressionHeader = actual
 			}
 		default:
 			var message = fmt.Sprintf(
@@ -902,22 +1304,29 @@ func Syntax(arguments ...any) SyntaxLike {
 	// Call the constructor.
 	var syntax = ast.Syntax().Make(
 		notice,
-		comment1,
+		directives,
+		imports,
+		ruleHeader,
+		optionalNewline,
 		rules,
-		comment2,
+		expressionHeader,
 		expressions,
+		modes,
 	)
 	return syntax
 }
 
 func Term(arguments ...any) TermLike {
 	// Initialize the possible arguments.
+	var lookahead LookaheadLike
 	var reference ReferenceLike
 	var literal string
 
 	// Process the actual arguments.
 	for _, argument := range arguments {
 		switch actual := argument.(type) {
+		case LookaheadLike:
+			lookahead = actual
 		case ReferenceLike:
 			reference = actual
 		case string:
@@ -943,6 +1352,8 @@ func Term(arguments ...any) TermLike {
 	// Call the constructor.
 	var term TermLike
 	switch {
+	case col.IsDefined(lookahead):
+		term = ast.Term().Make(lookahead)
 	case col.IsDefined(reference):
 		term = ast.Term().Make(reference)
 	case col.IsDefined(literal):
@@ -1006,6 +1417,38 @@ func Text(arguments ...any) TextLike {
 	return text
 }
 
+func Transition(arguments ...any) TransitionLike {
+	// Initialize the possible arguments.
+	var mode string
+
+	// Process the actual arguments.
+	for _, argument := range arguments {
+		switch actual := argument.(type) {
+		case string:
+			switch {
+			case MatchesType(actual, LowercaseToken):
+				mode = actual
+			default:
+				var message = fmt.Sprintf(
+					"An invalid string was passed into the transition constructor: %q\n",
+					actual,
+				)
+				panic(message)
+			}
+		default:
+			var message = fmt.Sprintf(
+				"An unknown argument type passed into the transition constructor: %T\n",
+				actual,
+			)
+			panic(message)
+		}
+	}
+
+	// Call the constructor.
+	var transition = ast.Transition().Make(mode)
+	return transition
+}
+
 // Grammar
 
 func Formatter(arguments ...any) FormatterLike {
@@ -1063,6 +1506,32 @@ func Visitor(arguments ...any) VisitorLike {
 	return visitor
 }
 
+// Generator
+
+func Templates(arguments ...any) TemplatesLike {
+	// Initialize the possible arguments.
+	var templates = gen.Templates().Make()
+
+	// Process the actual arguments in order, so later replacements win.
+	for _, argument := range arguments {
+		switch actual := argument.(type) {
+		case fsx.FS:
+			templates.LoadTemplates(actual)
+		case map[string]string:
+			for name, template := range actual {
+				templates.SetTemplate(name, template)
+			}
+		default:
+			var message = fmt.Sprintf(
+				"An unknown argument type passed into the templates constructor: %T\n",
+				actual,
+			)
+			panic(message)
+		}
+	}
+	return templates
+}
+
 // GLOBAL FUNCTIONS
 
 // Grammar
@@ -1073,6 +1542,11 @@ func FormatSyntax(syntax SyntaxLike) string {
 	return source
 }
 
+func FormatToken(token TokenLike) string {
+	var scannerClass = gra.Scanner()
+	return scannerClass.FormatToken(token)
+}
+
 func MatchesType(tokenValue string, tokenType TokenType) bool {
 	var scannerClass = gra.Scanner()
 	return scannerClass.MatchesType(tokenValue, tokenType)
@@ -1084,13 +1558,68 @@ func ParseSource(source string) SyntaxLike {
 	return syntax
 }
 
-func ValidateSyntax(syntax SyntaxLike) {
+func ParseSourceWithErrors(source string) (
+	syntax SyntaxLike,
+	errors abs.Sequential[ParseErrorLike],
+) {
+	var parser = gra.Parser().Make()
+	syntax, errors = parser.ParseSourceWithErrors(source)
+	return syntax, errors
+}
+
+func ScanSource(source string) abs.Sequential[TokenLike] {
+	// The scanner runs in the background so its queue must be drained here.
+	var queue = col.Queue[TokenLike](16)
+	gra.Scanner().Make(source, queue)
+	var tokens = col.List[TokenLike]()
+	var token, ok = queue.RemoveHead()
+	for ok {
+		tokens.AppendValue(token)
+		token, ok = queue.RemoveHead()
+	}
+	return tokens
+}
+
+func ValidateSyntax(syntax SyntaxLike) (warnings abs.Sequential[string]) {
 	var validator = gra.Validator().Make()
 	validator.ValidateSyntax(syntax)
+
+	// A valid syntax may still contain conflicts that are only warned about.
+	var analyzer = gen.Analyzer().Make()
+	analyzer.AnalyzeSyntax(syntax)
+	warnings = analyzer.GetWarnings()
+	return warnings
 }
 
 // Generator
 
+func CheckModule(
+	directory string,
+	module string,
+	wiki string,
+	syntax SyntaxLike,
+) (
+	differences abs.CatalogLike[string, string],
+) {
+	var generator = gen.Module().Make()
+	differences = generator.CheckModule(directory, module, wiki, syntax)
+	return differences
+}
+
+func CheckModuleWithTemplates(
+	directory string,
+	module string,
+	wiki string,
+	syntax SyntaxLike,
+	templates TemplatesLike,
+) (
+	differences abs.CatalogLike[string, string],
+) {
+	var generator = gen.Module().MakeWithTemplates(templates)
+	differences = generator.CheckModule(directory, module, wiki, syntax)
+	return differences
+}
+
 func GenerateAstModel(
 	wiki string,
 	syntax SyntaxLike,
@@ -1125,6 +1654,55 @@ func GenerateGrammarModel(
 	return implementation
 }
 
+func GenerateModule(
+	directory string,
+	module string,
+	wiki string,
+	syntax SyntaxLike,
+) (
+	changed abs.Sequential[string],
+) {
+	var generator = gen.Module().Make()
+	changed = generator.GenerateModule(directory, module, wiki, syntax)
+	return changed
+}
+
+func GenerateModuleWithTemplates(
+	directory string,
+	module string,
+	wiki string,
+	syntax SyntaxLike,
+	templates TemplatesLike,
+) (
+	changed abs.Sequential[string],
+) {
+	var generator = gen.Module().MakeWithTemplates(templates)
+	changed = generator.GenerateModule(directory, module, wiki, syntax)
+	return changed
+}
+
+func GenerateNodeClass(
+	syntax SyntaxLike,
+	className string,
+) (
+	implementation string,
+) {
+	var generator = gen.Node().Make()
+	implementation = generator.GenerateNodeClass(syntax, className)
+	return implementation
+}
+
+func GenerateParseErrorClass(
+	module string,
+	syntax SyntaxLike,
+) (
+	implementation string,
+) {
+	var generator = gen.ParseError().Make()
+	implementation = generator.GenerateParseErrorClass(module, syntax)
+	return implementation
+}
+
 func GenerateParserClass(
 	module string,
 	syntax SyntaxLike,
@@ -1201,3 +1779,37 @@ func GenerateVisitorClass(
 	implementation = generator.GenerateVisitorClass(module, syntax)
 	return implementation
 }
+
+func MergeSource(
+	existing string,
+	generated string,
+) (
+	merged string,
+) {
+	var merger = gen.Merger().Make()
+	merged = merger.MergeSource(existing, generated)
+	return merged
+}
+
+func ResolveSyntax(
+	directories abs.Sequential[string],
+	syntax SyntaxLike,
+) (
+	resolved SyntaxLike,
+) {
+	var resolver = gen.Resolver().Make(directories)
+	resolved = resolver.ResolveSyntax(syntax)
+	return resolved
+}
+
+func ResolveSyntaxFile(
+	directories abs.Sequential[string],
+	path string,
+	syntax SyntaxLike,
+) (
+	resolved SyntaxLike,
+) {
+	var resolver = gen.Resolver().Make(directories)
+	resolved = resolver.ResolveSyntaxFile(path, syntax)
+	return resolved
+}
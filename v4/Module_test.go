@@ -14,10 +14,12 @@ package module_test
 
 import (
 	gra "github.com/craterdog/go-grammar-framework/v4"
-	//mod "github.com/craterdog/go-model-framework/v4"
+	mdt "github.com/craterdog/go-grammar-framework/v4/moduletest"
 	ass "github.com/stretchr/testify/assert"
 	osx "os"
-	//sts "strings"
+	exe "os/exec"
+	fil "path/filepath"
+	sts "strings"
 	tes "testing"
 )
 
@@ -37,12 +39,13 @@ func TestRoundTrips(t *tes.T) {
 	var syntax = gra.ParseSource(source)
 	var actual = gra.FormatSyntax(syntax)
 	ass.Equal(t, actual, source)
-	gra.ValidateSyntax(syntax)
+
+	// The only warning is that no rule can be named after an intrinsic.
+	var warnings = gra.ValidateSyntax(syntax)
+	ass.Equal(t, 1, warnings.GetSize())
 }
 
-/*
-func TestModelGeneration(t *tes.T) {
-	// Parse the Syntax.cdsn file.
+func TestModuleGeneration(t *tes.T) {
 	var bytes, err = osx.ReadFile(syntaxFile)
 	if err != nil {
 		panic(err)
@@ -50,108 +53,129 @@ func TestModelGeneration(t *tes.T) {
 	var source = string(bytes)
 	var syntax = gra.ParseSource(source)
 
-	// Generate the AST model.
-	source = gra.GenerateAstModel(module, wiki, syntax)
-	bytes = []byte(source)
-	var filename = "ast/Package.go"
-	err = osx.WriteFile(filename, bytes, 0644)
-	if err != nil {
-		panic(err)
-	}
+	// Every file is written the first time the module is generated.
+	var directory = t.TempDir()
+	var changed = gra.GenerateModule(directory, module, wiki, syntax)
+	ass.True(t, changed.GetSize() > 10)
 
-	// Generate the AST classes.
-	var parser = mod.Parser()
-	var model = parser.ParseSource(source)
-	var classes = model.GetClasses().GetClasses().GetIterator()
-	for classes.HasNext() {
-		var class = classes.GetNext()
-		var name = sts.ToLower(sts.TrimSuffix(
-			class.GetDeclaration().GetName(),
-			"ClassLike",
-		))
-		var generator = mod.Generator()
-		source = generator.GenerateClass(model, name)
-		bytes = []byte(source)
-		var filename = "ast/" + name + ".go"
-		var err = osx.WriteFile(filename, bytes, 0644)
+	// The generated files match those in this module, except for the hand-tuned
+	// formatter and validator classes.
+	var entries []osx.DirEntry
+	for _, name := range []string{"ast", "grammar"} {
+		entries, err = osx.ReadDir(name)
 		if err != nil {
 			panic(err)
 		}
+		for _, entry := range entries {
+			var filename = fil.Join(name, entry.Name())
+			switch {
+			case sts.HasSuffix(filename, "_test.go"):
+			case filename == "grammar/formatter.go":
+			case filename == "grammar/validator.go":
+			default:
+				var expected, _ = osx.ReadFile(filename)
+				var actual, _ = osx.ReadFile(fil.Join(directory, filename))
+				ass.Equal(t, string(expected), string(actual), filename)
+			}
+		}
 	}
 
-	// Generate the grammar model.
-	source = gra.GenerateGrammarModel(module, wiki, syntax)
-	bytes = []byte(source)
-	filename = "grammar/Package.go"
-	err = osx.WriteFile(filename, bytes, 0644)
-	if err != nil {
-		panic(err)
-	}
+	// Nothing changes when the module is generated again.
+	changed = gra.GenerateModule(directory, module, wiki, syntax)
+	ass.True(t, changed.IsEmpty())
+}
 
-	// Generate the processor class for the grammar.
-	source = gra.GenerateProcessorClass(module, syntax)
-	bytes = []byte(source)
-	filename = "grammar/processor.go"
-	err = osx.WriteFile(filename, bytes, 0644)
-	if err != nil {
-		panic(err)
-	}
+func TestModuleDrift(t *tes.T) {
+	var source = gra.GenerateSyntaxNotation("demo", "")
+	var syntax = gra.ParseSource(source)
 
-	// Generate the visitor class for the grammar.
-	source = gra.GenerateVisitorClass(module, syntax)
-	bytes = []byte(source)
-	filename = "grammar/visitor.go"
-	err = osx.WriteFile(filename, bytes, 0644)
-	if err != nil {
-		panic(err)
-	}
+	// A freshly generated module builds and has not drifted from its syntax.
+	var directory = generateModule(t, "drift", source)
+	buildModule(t, directory)
+	var module = module + "/" + directory
+	var differences = gra.CheckModule(directory, module, wiki, syntax)
+	ass.True(t, differences.IsEmpty())
 
-	// Generate the token class for the grammar.
-	source = gra.GenerateTokenClass(module, syntax)
-	bytes = []byte(source)
-	filename = "grammar/token.go"
-	err = osx.WriteFile(filename, bytes, 0644)
-	if err != nil {
-		panic(err)
-	}
+	// Edited and missing files are reported with a unified diff.
+	var token = fil.Join(directory, "grammar", "token.go")
+	var bytes, _ = osx.ReadFile(token)
+	var edited = sts.Replace(string(bytes), "\treturn v.value_\n", "\treturn \"\"\n", 1)
+	osx.WriteFile(token, []byte(edited), 0644)
+	var visitor = fil.Join(directory, "grammar", "visitor.go")
+	osx.Remove(visitor)
+	differences = gra.CheckModule(directory, module, wiki, syntax)
+	ass.Equal(t, 2, differences.GetSize())
+	var difference = differences.GetValue(token)
+	ass.True(t, sts.HasPrefix(difference, "--- "+token+"\n+++ "+token+" (generated)\n@@ -"))
+	ass.Contains(t, difference, "\n-\treturn \"\"\n+\treturn v.value_\n")
+	difference = differences.GetValue(visitor)
+	ass.True(t, sts.HasPrefix(difference, "--- /dev/null\n+++ "+visitor+" (generated)\n@@ -0,0 +1,"))
+}
 
-	// Generate the scanner class for the grammar.
-	source = gra.GenerateScannerClass(module, syntax)
-	bytes = []byte(source)
-	filename = "grammar/scanner.go"
-	err = osx.WriteFile(filename, bytes, 0644)
-	if err != nil {
-		panic(err)
-	}
+const handWritten = `//cdsn:preserve
+func (v *formatter_) PreprocessCardinality(cardinality ast.CardinalityLike) {
+	v.appendString(sts.Repeat(" ", int(v.depth_)))
+}
 
-	// Generate the parser class for the grammar.
-	source = gra.GenerateParserClass(module, syntax)
-	bytes = []byte(source)
-	filename = "grammar/parser.go"
-	err = osx.WriteFile(filename, bytes, 0644)
-	if err != nil {
-		panic(err)
-	}
+// appendSpace is a hand-written helper.
+//
+//cdsn:preserve
+func (v *formatter_) appendSpace() {
+	v.appendString(" ")
+}
+`
 
-	// Generate the validator class for the grammar.
-	source = gra.GenerateValidatorClass(module, syntax)
-	bytes = []byte(source)
-	filename = "grammar/validator.go"
-	err = osx.WriteFile(filename, bytes, 0644)
+func TestPreservedRegions(t *tes.T) {
+	var bytes, err = osx.ReadFile(syntaxFile)
 	if err != nil {
 		panic(err)
 	}
+	var source = string(bytes)
+	var syntax = gra.ParseSource(source)
+	var directory = t.TempDir()
+	gra.GenerateModule(directory, module, wiki, syntax)
+
+	// Replace a generated method with hand-written ones marked as preserved.
+	var formatter = fil.Join(directory, "grammar", "formatter.go")
+	bytes, _ = osx.ReadFile(formatter)
+	var stub = `func (v *formatter_) PreprocessCardinality(cardinality ast.CardinalityLike) {
+	// TBD - Add formatting of the delimited rule.
+}
+`
+	ass.Contains(t, string(bytes), stub)
+	var edited = sts.Replace(string(bytes), stub, handWritten, 1)
+	osx.WriteFile(formatter, []byte(edited), 0644)
 
-	// Generate the formatter class for the grammar.
-	source = gra.GenerateFormatterClass(module, syntax)
-	bytes = []byte(source)
-	filename = "grammar/formatter.go"
-	err = osx.WriteFile(filename, bytes, 0644)
+	// The hand-written methods survive regeneration and are not reported as
+	// drift, while unmarked edits are still overwritten.
+	var changed = gra.GenerateModule(directory, module, wiki, syntax)
+	ass.True(t, changed.IsEmpty())
+	ass.True(t, gra.CheckModule(directory, module, wiki, syntax).IsEmpty())
+	var unmarked = sts.Replace(edited, "return v.getResult()", `return ""`, 1)
+	osx.WriteFile(formatter, []byte(unmarked), 0644)
+	changed = gra.GenerateModule(directory, module, wiki, syntax)
+	ass.Equal(t, []string{formatter}, changed.AsArray())
+	bytes, _ = osx.ReadFile(formatter)
+	ass.Equal(t, edited, string(bytes))
+}
+
+func TestGeneratedCode(t *tes.T) {
+	var bytes, err = osx.ReadFile(syntaxFile)
 	if err != nil {
 		panic(err)
 	}
+	var source = string(bytes)
+	var syntax = gra.ParseSource(source)
+	mdt.VerifyModule(
+		t,
+		".",
+		module,
+		wiki,
+		syntax,
+		"grammar/formatter.go",
+		"grammar/validator.go",
+	)
 }
-*/
 
 func TestLifecycle(t *tes.T) {
 	var name = "example"
@@ -190,3 +214,125 @@ func TestLifecycle(t *tes.T) {
 	// Generate the validator class for the syntax.
 	gra.GenerateValidatorClass(module, syntax)
 }
+
+const indentedSyntax = `!>
+NESTED BLOCKS
+<!
+
+$indentation
+
+!>
+RULES
+<!
+Document: Block+
+
+Block: name ":" newline Body?
+
+Body: indent Block+ dedent
+
+!>
+EXPRESSIONS
+<!
+name: LOWER+
+
+newline: EOL
+
+`
+
+const minimalSyntax = `!>
+MINIMAL
+<!
+
+!>
+RULES
+<!
+Document: name
+
+!>
+EXPRESSIONS
+<!
+name: LOWER+
+
+`
+
+func TestGeneratedModules(t *tes.T) {
+	// Each generated module must compile and pass the vet checks.
+	var demo = gra.GenerateSyntaxNotation("demo", "")
+	for name, source := range map[string]string{
+		"demo":     demo,
+		"indented": indentedSyntax,
+		"minimal":  minimalSyntax,
+	} {
+		var directory = generateModule(t, name, source)
+		buildModule(t, directory)
+	}
+}
+
+const nestedBlocks = `package main
+
+import (
+	fmt "fmt"
+	ast "<module>/ast"
+	gra "<module>/grammar"
+	sts "strings"
+)
+
+func main() {
+	var source = "a:\n  b:\n    c:\n  d:\ne:\n"
+	var document = gra.Parser().Make().ParseSource(source)
+	gra.Validator().Make().ValidateDocument(document)
+	printBlocks(document.GetBlocks().AsArray(), 0)
+
+	// An inconsistent indentation is reported where it begins.
+	var _, errors = gra.Parser().Make().ParseSourceWithErrors("a:\n    b:\n  c:\n")
+	fmt.Println(errors.GetIterator().GetNext().Error())
+}
+
+func printBlocks(blocks []ast.BlockLike, depth int) {
+	for _, block := range blocks {
+		fmt.Println(sts.Repeat("  ", depth) + block.GetName())
+		var body = block.GetOptionalBody()
+		if body != nil {
+			printBlocks(body.GetBlocks().AsArray(), depth+1)
+		}
+	}
+}
+`
+
+func TestIndentedModule(t *tes.T) {
+	// The indentation tokens delimit the nested blocks.
+	var directory = generateModule(t, "nested", indentedSyntax)
+	var program = sts.ReplaceAll(nestedBlocks, "<module>", module+"/"+directory)
+	osx.Mkdir(fil.Join(directory, "main"), 0755)
+	osx.WriteFile(fil.Join(directory, "main", "main.go"), []byte(program), 0644)
+	var output, err = exe.Command("go", "run", "./"+directory+"/main").CombinedOutput()
+	ass.NoError(t, err, string(output))
+	ass.Equal(
+		t,
+		"a\n  b\n    c\n  d\ne\n"+
+			"3:1: unexpected error \"  \" (The indentation does not match any enclosing indentation.)\n",
+		string(output),
+	)
+}
+
+func buildModule(t *tes.T, directory string) {
+	for _, command := range []string{"build", "vet"} {
+		var output, err = exe.Command("go", command, "./"+directory+"/...").CombinedOutput()
+		ass.NoError(t, err, "go %v %v:\n%s", command, directory, output)
+	}
+}
+
+func generateModule(t *tes.T, name string, source string) string {
+	// The module is generated within this one so that it shares its
+	// dependencies.
+	var directory, err = osx.MkdirTemp(".", name)
+	if err != nil {
+		panic(err)
+	}
+	directory = fil.Base(directory)
+	t.Cleanup(func() { osx.RemoveAll(directory) })
+	var syntax = gra.ParseSource(source)
+	gra.ValidateSyntax(syntax)
+	gra.GenerateModule(directory, module+"/"+directory, wiki, syntax)
+	return directory
+}
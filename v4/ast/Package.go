@@ -41,10 +41,14 @@ class constants, constructors and functions that must be supported by each
 concrete alternative-like class.
 */
 type AlternativeClassLike interface {
-	// Constructor
+	// Constructors
 	Make(
 		option OptionLike,
 	) AlternativeLike
+	MakeWithSpan(
+		span SpanLike,
+		option OptionLike,
+	) AlternativeLike
 }
 
 /*
@@ -53,10 +57,14 @@ class constants, constructors and functions that must be supported by each
 concrete cardinality-like class.
 */
 type CardinalityClassLike interface {
-	// Constructor
+	// Constructors
 	Make(
 		any_ any,
 	) CardinalityLike
+	MakeWithSpan(
+		span SpanLike,
+		any_ any,
+	) CardinalityLike
 }
 
 /*
@@ -65,10 +73,14 @@ class constants, constructors and functions that must be supported by each
 concrete character-like class.
 */
 type CharacterClassLike interface {
-	// Constructor
+	// Constructors
 	Make(
 		any_ any,
 	) CharacterLike
+	MakeWithSpan(
+		span SpanLike,
+		any_ any,
+	) CharacterLike
 }
 
 /*
@@ -77,10 +89,14 @@ class constants, constructors and functions that must be supported by each
 concrete constrained-like class.
 */
 type ConstrainedClassLike interface {
-	// Constructor
+	// Constructors
 	Make(
 		any_ any,
 	) ConstrainedLike
+	MakeWithSpan(
+		span SpanLike,
+		any_ any,
+	) ConstrainedLike
 }
 
 /*
@@ -89,10 +105,34 @@ class constants, constructors and functions that must be supported by each
 concrete definition-like class.
 */
 type DefinitionClassLike interface {
-	// Constructor
+	// Constructors
 	Make(
 		any_ any,
 	) DefinitionLike
+	MakeWithSpan(
+		span SpanLike,
+		any_ any,
+	) DefinitionLike
+}
+
+/*
+DirectiveClassLike is a class interface that defines the complete set of
+class constants, constructors and functions that must be supported by each
+concrete directive-like class.
+*/
+type DirectiveClassLike interface {
+	// Constructors
+	Make(
+		lowercase string,
+		optionalNote string,
+		newlines abs.Sequential[string],
+	) DirectiveLike
+	MakeWithSpan(
+		span SpanLike,
+		lowercase string,
+		optionalNote string,
+		newlines abs.Sequential[string],
+	) DirectiveLike
 }
 
 /*
@@ -101,10 +141,14 @@ class constants, constructors and functions that must be supported by each
 concrete element-like class.
 */
 type ElementClassLike interface {
-	// Constructor
+	// Constructors
 	Make(
 		any_ any,
 	) ElementLike
+	MakeWithSpan(
+		span SpanLike,
+		any_ any,
+	) ElementLike
 }
 
 /*
@@ -113,11 +157,16 @@ class constants, constructors and functions that must be supported by each
 concrete explicit-like class.
 */
 type ExplicitClassLike interface {
-	// Constructor
+	// Constructors
 	Make(
 		glyph string,
 		optionalExtent ExtentLike,
 	) ExplicitLike
+	MakeWithSpan(
+		span SpanLike,
+		glyph string,
+		optionalExtent ExtentLike,
+	) ExplicitLike
 }
 
 /*
@@ -126,10 +175,21 @@ class constants, constructors and functions that must be supported by each
 concrete expression-like class.
 */
 type ExpressionClassLike interface {
-	// Constructor
+	// Constructors
 	Make(
 		lowercase string,
+		optionalCaseless string,
+		pattern PatternLike,
+		optionalTransition TransitionLike,
+		optionalNote string,
+		newlines abs.Sequential[string],
+	) ExpressionLike
+	MakeWithSpan(
+		span SpanLike,
+		lowercase string,
+		optionalCaseless string,
 		pattern PatternLike,
+		optionalTransition TransitionLike,
 		optionalNote string,
 		newlines abs.Sequential[string],
 	) ExpressionLike
@@ -141,10 +201,14 @@ class constants, constructors and functions that must be supported by each
 concrete extent-like class.
 */
 type ExtentClassLike interface {
-	// Constructor
+	// Constructors
 	Make(
 		glyph string,
 	) ExtentLike
+	MakeWithSpan(
+		span SpanLike,
+		glyph string,
+	) ExtentLike
 }
 
 /*
@@ -153,11 +217,16 @@ class constants, constructors and functions that must be supported by each
 concrete filter-like class.
 */
 type FilterClassLike interface {
-	// Constructor
+	// Constructors
 	Make(
 		optionalExcluded string,
 		characters abs.Sequential[CharacterLike],
 	) FilterLike
+	MakeWithSpan(
+		span SpanLike,
+		optionalExcluded string,
+		characters abs.Sequential[CharacterLike],
+	) FilterLike
 }
 
 /*
@@ -166,10 +235,14 @@ class constants, constructors and functions that must be supported by each
 concrete group-like class.
 */
 type GroupClassLike interface {
-	// Constructor
+	// Constructors
 	Make(
 		pattern PatternLike,
 	) GroupLike
+	MakeWithSpan(
+		span SpanLike,
+		pattern PatternLike,
+	) GroupLike
 }
 
 /*
@@ -178,10 +251,34 @@ class constants, constructors and functions that must be supported by each
 concrete identifier-like class.
 */
 type IdentifierClassLike interface {
-	// Constructor
+	// Constructors
 	Make(
 		any_ any,
 	) IdentifierLike
+	MakeWithSpan(
+		span SpanLike,
+		any_ any,
+	) IdentifierLike
+}
+
+/*
+ImportClassLike is a class interface that defines the complete set of
+class constants, constructors and functions that must be supported by each
+concrete import-like class.
+*/
+type ImportClassLike interface {
+	// Constructors
+	Make(
+		literal string,
+		optionalNote string,
+		newlines abs.Sequential[string],
+	) ImportLike
+	MakeWithSpan(
+		span SpanLike,
+		literal string,
+		optionalNote string,
+		newlines abs.Sequential[string],
+	) ImportLike
 }
 
 /*
@@ -190,11 +287,54 @@ class constants, constructors and functions that must be supported by each
 concrete inline-like class.
 */
 type InlineClassLike interface {
-	// Constructor
+	// Constructors
 	Make(
 		terms abs.Sequential[TermLike],
 		optionalNote string,
 	) InlineLike
+	MakeWithSpan(
+		span SpanLike,
+		terms abs.Sequential[TermLike],
+		optionalNote string,
+	) InlineLike
+}
+
+/*
+LabelClassLike is a class interface that defines the complete set of
+class constants, constructors and functions that must be supported by each
+concrete label-like class.
+*/
+type LabelClassLike interface {
+	// Constructors
+	Make(
+		lowercase string,
+	) LabelLike
+	MakeWithSpan(
+		span SpanLike,
+		lowercase string,
+	) LabelLike
+}
+
+/*
+LevelClassLike is a class interface that defines the complete set of
+class constants, constructors and functions that must be supported by each
+concrete level-like class.
+*/
+type LevelClassLike interface {
+	// Constructors
+	Make(
+		associativity string,
+		operators abs.Sequential[OperatorLike],
+		optionalNote string,
+		newline string,
+	) LevelLike
+	MakeWithSpan(
+		span SpanLike,
+		associativity string,
+		operators abs.Sequential[OperatorLike],
+		optionalNote string,
+		newline string,
+	) LevelLike
 }
 
 /*
@@ -203,10 +343,14 @@ class constants, constructors and functions that must be supported by each
 concrete limit-like class.
 */
 type LimitClassLike interface {
-	// Constructor
+	// Constructors
 	Make(
 		optionalNumber string,
 	) LimitLike
+	MakeWithSpan(
+		span SpanLike,
+		optionalNumber string,
+	) LimitLike
 }
 
 /*
@@ -215,12 +359,58 @@ class constants, constructors and functions that must be supported by each
 concrete line-like class.
 */
 type LineClassLike interface {
-	// Constructor
+	// Constructors
 	Make(
 		identifier IdentifierLike,
 		optionalNote string,
 		newline string,
 	) LineLike
+	MakeWithSpan(
+		span SpanLike,
+		identifier IdentifierLike,
+		optionalNote string,
+		newline string,
+	) LineLike
+}
+
+/*
+LookaheadClassLike is a class interface that defines the complete set of
+class constants, constructors and functions that must be supported by each
+concrete lookahead-like class.
+*/
+type LookaheadClassLike interface {
+	// Constructors
+	Make(
+		predicate string,
+		term TermLike,
+	) LookaheadLike
+	MakeWithSpan(
+		span SpanLike,
+		predicate string,
+		term TermLike,
+	) LookaheadLike
+}
+
+/*
+ModeClassLike is a class interface that defines the complete set of
+class constants, constructors and functions that must be supported by each
+concrete mode-like class.
+*/
+type ModeClassLike interface {
+	// Constructors
+	Make(
+		lowercase string,
+		optionalNote string,
+		newlines abs.Sequential[string],
+		expressions abs.Sequential[ExpressionLike],
+	) ModeLike
+	MakeWithSpan(
+		span SpanLike,
+		lowercase string,
+		optionalNote string,
+		newlines abs.Sequential[string],
+		expressions abs.Sequential[ExpressionLike],
+	) ModeLike
 }
 
 /*
@@ -229,11 +419,16 @@ class constants, constructors and functions that must be supported by each
 concrete multiline-like class.
 */
 type MultilineClassLike interface {
-	// Constructor
+	// Constructors
 	Make(
 		newline string,
 		lines abs.Sequential[LineLike],
 	) MultilineLike
+	MakeWithSpan(
+		span SpanLike,
+		newline string,
+		lines abs.Sequential[LineLike],
+	) MultilineLike
 }
 
 /*
@@ -242,11 +437,32 @@ class constants, constructors and functions that must be supported by each
 concrete notice-like class.
 */
 type NoticeClassLike interface {
-	// Constructor
+	// Constructors
 	Make(
 		comment string,
 		newline string,
 	) NoticeLike
+	MakeWithSpan(
+		span SpanLike,
+		comment string,
+		newline string,
+	) NoticeLike
+}
+
+/*
+OperatorClassLike is a class interface that defines the complete set of
+class constants, constructors and functions that must be supported by each
+concrete operator-like class.
+*/
+type OperatorClassLike interface {
+	// Constructors
+	Make(
+		literal string,
+	) OperatorLike
+	MakeWithSpan(
+		span SpanLike,
+		literal string,
+	) OperatorLike
 }
 
 /*
@@ -255,10 +471,14 @@ class constants, constructors and functions that must be supported by each
 concrete option-like class.
 */
 type OptionClassLike interface {
-	// Constructor
+	// Constructors
 	Make(
 		repetitions abs.Sequential[RepetitionLike],
 	) OptionLike
+	MakeWithSpan(
+		span SpanLike,
+		repetitions abs.Sequential[RepetitionLike],
+	) OptionLike
 }
 
 /*
@@ -267,11 +487,38 @@ class constants, constructors and functions that must be supported by each
 concrete pattern-like class.
 */
 type PatternClassLike interface {
-	// Constructor
+	// Constructors
 	Make(
 		option OptionLike,
 		alternatives abs.Sequential[AlternativeLike],
 	) PatternLike
+	MakeWithSpan(
+		span SpanLike,
+		option OptionLike,
+		alternatives abs.Sequential[AlternativeLike],
+	) PatternLike
+}
+
+/*
+PrecedenceClassLike is a class interface that defines the complete set of
+class constants, constructors and functions that must be supported by each
+concrete precedence-like class.
+*/
+type PrecedenceClassLike interface {
+	// Constructors
+	Make(
+		identifier IdentifierLike,
+		optionalNote string,
+		newline string,
+		levels abs.Sequential[LevelLike],
+	) PrecedenceLike
+	MakeWithSpan(
+		span SpanLike,
+		identifier IdentifierLike,
+		optionalNote string,
+		newline string,
+		levels abs.Sequential[LevelLike],
+	) PrecedenceLike
 }
 
 /*
@@ -280,11 +527,16 @@ class constants, constructors and functions that must be supported by each
 concrete quantified-like class.
 */
 type QuantifiedClassLike interface {
-	// Constructor
+	// Constructors
 	Make(
 		number string,
 		optionalLimit LimitLike,
 	) QuantifiedLike
+	MakeWithSpan(
+		span SpanLike,
+		number string,
+		optionalLimit LimitLike,
+	) QuantifiedLike
 }
 
 /*
@@ -293,10 +545,19 @@ class constants, constructors and functions that must be supported by each
 concrete reference-like class.
 */
 type ReferenceClassLike interface {
-	// Constructor
+	// Constructors
 	Make(
+		optionalLabel LabelLike,
 		identifier IdentifierLike,
 		optionalCardinality CardinalityLike,
+		optionalSeparator SeparatorLike,
+	) ReferenceLike
+	MakeWithSpan(
+		span SpanLike,
+		optionalLabel LabelLike,
+		identifier IdentifierLike,
+		optionalCardinality CardinalityLike,
+		optionalSeparator SeparatorLike,
 	) ReferenceLike
 }
 
@@ -306,11 +567,16 @@ class constants, constructors and functions that must be supported by each
 concrete repetition-like class.
 */
 type RepetitionClassLike interface {
-	// Constructor
+	// Constructors
 	Make(
 		element ElementLike,
 		optionalCardinality CardinalityLike,
 	) RepetitionLike
+	MakeWithSpan(
+		span SpanLike,
+		element ElementLike,
+		optionalCardinality CardinalityLike,
+	) RepetitionLike
 }
 
 /*
@@ -319,12 +585,36 @@ class constants, constructors and functions that must be supported by each
 concrete rule-like class.
 */
 type RuleClassLike interface {
-	// Constructor
+	// Constructors
 	Make(
 		uppercase string,
 		definition DefinitionLike,
 		newlines abs.Sequential[string],
 	) RuleLike
+	MakeWithSpan(
+		span SpanLike,
+		uppercase string,
+		definition DefinitionLike,
+		newlines abs.Sequential[string],
+	) RuleLike
+}
+
+/*
+SeparatorClassLike is a class interface that defines the complete set of
+class constants, constructors and functions that must be supported by each
+concrete separator-like class.
+*/
+type SeparatorClassLike interface {
+	// Constructors
+	Make(
+		literal string,
+		optionalTrailing string,
+	) SeparatorLike
+	MakeWithSpan(
+		span SpanLike,
+		literal string,
+		optionalTrailing string,
+	) SeparatorLike
 }
 
 /*
@@ -333,13 +623,29 @@ class constants, constructors and functions that must be supported by each
 concrete syntax-like class.
 */
 type SyntaxClassLike interface {
-	// Constructor
+	// Constructors
 	Make(
 		notice NoticeLike,
-		comment1 string,
+		directives abs.Sequential[DirectiveLike],
+		imports abs.Sequential[ImportLike],
+		ruleHeader string,
+		optionalNewline string,
 		rules abs.Sequential[RuleLike],
-		comment2 string,
+		expressionHeader string,
 		expressions abs.Sequential[ExpressionLike],
+		modes abs.Sequential[ModeLike],
+	) SyntaxLike
+	MakeWithSpan(
+		span SpanLike,
+		notice NoticeLike,
+		directives abs.Sequential[DirectiveLike],
+		imports abs.Sequential[ImportLike],
+		ruleHeader string,
+		optionalNewline string,
+		rules abs.Sequential[RuleLike],
+		expressionHeader string,
+		expressions abs.Sequential[ExpressionLike],
+		modes abs.Sequential[ModeLike],
 	) SyntaxLike
 }
 
@@ -349,10 +655,14 @@ class constants, constructors and functions that must be supported by each
 concrete term-like class.
 */
 type TermClassLike interface {
-	// Constructor
+	// Constructors
 	Make(
 		any_ any,
 	) TermLike
+	MakeWithSpan(
+		span SpanLike,
+		any_ any,
+	) TermLike
 }
 
 /*
@@ -361,10 +671,62 @@ class constants, constructors and functions that must be supported by each
 concrete text-like class.
 */
 type TextClassLike interface {
-	// Constructor
+	// Constructors
 	Make(
 		any_ any,
 	) TextLike
+	MakeWithSpan(
+		span SpanLike,
+		any_ any,
+	) TextLike
+}
+
+/*
+TransitionClassLike is a class interface that defines the complete set of
+class constants, constructors and functions that must be supported by each
+concrete transition-like class.
+*/
+type TransitionClassLike interface {
+	// Constructors
+	Make(
+		optionalMode string,
+	) TransitionLike
+	MakeWithSpan(
+		span SpanLike,
+		optionalMode string,
+	) TransitionLike
+}
+
+/*
+PositionClassLike is a class interface that defines the complete set of
+class constants, constructors and functions that must be supported by each
+concrete position-like class.
+*/
+type PositionClassLike interface {
+	// Constructor
+	Make(
+		line uint,
+		column uint,
+		offset uint,
+	) PositionLike
+}
+
+/*
+SpanClassLike is a class interface that defines the complete set of
+class constants, constructors and functions that must be supported by each
+concrete span-like class.
+*/
+type SpanClassLike interface {
+	// Constructors
+	Make(
+		start PositionLike,
+		end PositionLike,
+	) SpanLike
+	MakeWithSource(
+		source string,
+		start PositionLike,
+		end PositionLike,
+	) SpanLike
 }
 
 // Instances
@@ -380,6 +742,7 @@ type AlternativeLike interface {
 
 	// Attribute
 	GetOption() OptionLike
+	GetSpan() SpanLike
 }
 
 /*
@@ -393,6 +756,7 @@ type CardinalityLike interface {
 
 	// Attribute
 	GetAny() any
+	GetSpan() SpanLike
 }
 
 /*
@@ -406,6 +770,7 @@ type CharacterLike interface {
 
 	// Attribute
 	GetAny() any
+	GetSpan() SpanLike
 }
 
 /*
@@ -419,6 +784,7 @@ type ConstrainedLike interface {
 
 	// Attribute
 	GetAny() any
+	GetSpan() SpanLike
 }
 
 /*
@@ -432,6 +798,23 @@ type DefinitionLike interface {
 
 	// Attribute
 	GetAny() any
+	GetSpan() SpanLike
+}
+
+/*
+DirectiveLike is an instance interface that defines the complete set of
+instance attributes, abstractions and methods that must be supported by each
+instance of a concrete directive-like class.
+*/
+type DirectiveLike interface {
+	// Public
+	GetClass() DirectiveClassLike
+
+	// Attribute
+	GetLowercase() string
+	GetOptionalNote() string
+	GetNewlines() abs.Sequential[string]
+	GetSpan() SpanLike
 }
 
 /*
@@ -445,6 +828,7 @@ type ElementLike interface {
 
 	// Attribute
 	GetAny() any
+	GetSpan() SpanLike
 }
 
 /*
@@ -459,6 +843,7 @@ type ExplicitLike interface {
 	// Attribute
 	GetGlyph() string
 	GetOptionalExtent() ExtentLike
+	GetSpan() SpanLike
 }
 
 /*
@@ -472,9 +857,12 @@ type ExpressionLike interface {
 
 	// Attribute
 	GetLowercase() string
+	GetOptionalCaseless() string
 	GetPattern() PatternLike
+	GetOptionalTransition() TransitionLike
 	GetOptionalNote() string
 	GetNewlines() abs.Sequential[string]
+	GetSpan() SpanLike
 }
 
 /*
@@ -488,6 +876,7 @@ type ExtentLike interface {
 
 	// Attribute
 	GetGlyph() string
+	GetSpan() SpanLike
 }
 
 /*
@@ -502,6 +891,7 @@ type FilterLike interface {
 	// Attribute
 	GetOptionalExcluded() string
 	GetCharacters() abs.Sequential[CharacterLike]
+	GetSpan() SpanLike
 }
 
 /*
@@ -515,6 +905,7 @@ type GroupLike interface {
 
 	// Attribute
 	GetPattern() PatternLike
+	GetSpan() SpanLike
 }
 
 /*
@@ -528,6 +919,23 @@ type IdentifierLike interface {
 
 	// Attribute
 	GetAny() any
+	GetSpan() SpanLike
+}
+
+/*
+ImportLike is an instance interface that defines the complete set of
+instance attributes, abstractions and methods that must be supported by each
+instance of a concrete import-like class.
+*/
+type ImportLike interface {
+	// Public
+	GetClass() ImportClassLike
+
+	// Attribute
+	GetLiteral() string
+	GetOptionalNote() string
+	GetNewlines() abs.Sequential[string]
+	GetSpan() SpanLike
 }
 
 /*
@@ -542,6 +950,38 @@ type InlineLike interface {
 	// Attribute
 	GetTerms() abs.Sequential[TermLike]
 	GetOptionalNote() string
+	GetSpan() SpanLike
+}
+
+/*
+LabelLike is an instance interface that defines the complete set of
+instance attributes, abstractions and methods that must be supported by each
+instance of a concrete label-like class.
+*/
+type LabelLike interface {
+	// Public
+	GetClass() LabelClassLike
+
+	// Attribute
+	GetLowercase() string
+	GetSpan() SpanLike
+}
+
+/*
+LevelLike is an instance interface that defines the complete set of
+instance attributes, abstractions and methods that must be supported by each
+instance of a concrete level-like class.
+*/
+type LevelLike interface {
+	// Public
+	GetClass() LevelClassLike
+
+	// Attribute
+	GetAssociativity() string
+	GetOperators() abs.Sequential[OperatorLike]
+	GetOptionalNote() string
+	GetNewline() string
+	GetSpan() SpanLike
 }
 
 /*
@@ -555,6 +995,7 @@ type LimitLike interface {
 
 	// Attribute
 	GetOptionalNumber() string
+	GetSpan() SpanLike
 }
 
 /*
@@ -570,6 +1011,39 @@ type LineLike interface {
 	GetIdentifier() IdentifierLike
 	GetOptionalNote() string
 	GetNewline() string
+	GetSpan() SpanLike
+}
+
+/*
+LookaheadLike is an instance interface that defines the complete set of
+instance attributes, abstractions and methods that must be supported by each
+instance of a concrete lookahead-like class.
+*/
+type LookaheadLike interface {
+	// Public
+	GetClass() LookaheadClassLike
+
+	// Attribute
+	GetPredicate() string
+	GetTerm() TermLike
+	GetSpan() SpanLike
+}
+
+/*
+ModeLike is an instance interface that defines the complete set of
+instance attributes, abstractions and methods that must be supported by each
+instance of a concrete mode-like class.
+*/
+type ModeLike interface {
+	// Public
+	GetClass() ModeClassLike
+
+	// Attribute
+	GetLowercase() string
+	GetOptionalNote() string
+	GetNewlines() abs.Sequential[string]
+	GetExpressions() abs.Sequential[ExpressionLike]
+	GetSpan() SpanLike
 }
 
 /*
@@ -584,6 +1058,7 @@ type MultilineLike interface {
 	// Attribute
 	GetNewline() string
 	GetLines() abs.Sequential[LineLike]
+	GetSpan() SpanLike
 }
 
 /*
@@ -598,6 +1073,21 @@ type NoticeLike interface {
 	// Attribute
 	GetComment() string
 	GetNewline() string
+	GetSpan() SpanLike
+}
+
+/*
+OperatorLike is an instance interface that defines the complete set of
+instance attributes, abstractions and methods that must be supported by each
+instance of a concrete operator-like class.
+*/
+type OperatorLike interface {
+	// Public
+	GetClass() OperatorClassLike
+
+	// Attribute
+	GetLiteral() string
+	GetSpan() SpanLike
 }
 
 /*
@@ -611,6 +1101,7 @@ type OptionLike interface {
 
 	// Attribute
 	GetRepetitions() abs.Sequential[RepetitionLike]
+	GetSpan() SpanLike
 }
 
 /*
@@ -625,6 +1116,24 @@ type PatternLike interface {
 	// Attribute
 	GetOption() OptionLike
 	GetAlternatives() abs.Sequential[AlternativeLike]
+	GetSpan() SpanLike
+}
+
+/*
+PrecedenceLike is an instance interface that defines the complete set of
+instance attributes, abstractions and methods that must be supported by each
+instance of a concrete precedence-like class.
+*/
+type PrecedenceLike interface {
+	// Public
+	GetClass() PrecedenceClassLike
+
+	// Attribute
+	GetIdentifier() IdentifierLike
+	GetOptionalNote() string
+	GetNewline() string
+	GetLevels() abs.Sequential[LevelLike]
+	GetSpan() SpanLike
 }
 
 /*
@@ -639,6 +1148,7 @@ type QuantifiedLike interface {
 	// Attribute
 	GetNumber() string
 	GetOptionalLimit() LimitLike
+	GetSpan() SpanLike
 }
 
 /*
@@ -651,8 +1161,11 @@ type ReferenceLike interface {
 	GetClass() ReferenceClassLike
 
 	// Attribute
+	GetOptionalLabel() LabelLike
 	GetIdentifier() IdentifierLike
 	GetOptionalCardinality() CardinalityLike
+	GetOptionalSeparator() SeparatorLike
+	GetSpan() SpanLike
 }
 
 /*
@@ -667,6 +1180,7 @@ type RepetitionLike interface {
 	// Attribute
 	GetElement() ElementLike
 	GetOptionalCardinality() CardinalityLike
+	GetSpan() SpanLike
 }
 
 /*
@@ -682,6 +1196,22 @@ type RuleLike interface {
 	GetUppercase() string
 	GetDefinition() DefinitionLike
 	GetNewlines() abs.Sequential[string]
+	GetSpan() SpanLike
+}
+
+/*
+SeparatorLike is an instance interface that defines the complete set of
+instance attributes, abstractions and methods that must be supported by each
+instance of a concrete separator-like class.
+*/
+type SeparatorLike interface {
+	// Public
+	GetClass() SeparatorClassLike
+
+	// Attribute
+	GetLiteral() string
+	GetOptionalTrailing() string
+	GetSpan() SpanLike
 }
 
 /*
@@ -695,10 +1225,15 @@ type SyntaxLike interface {
 
 	// Attribute
 	GetNotice() NoticeLike
-	GetComment1() string
+	GetDirectives() abs.Sequential[DirectiveLike]
+	GetImports() abs.Sequential[ImportLike]
+	GetRuleHeader() string
+	GetOptionalNewline() string
 	GetRules() abs.Sequential[RuleLike]
-	GetComment2() string
+	GetExpressionHeader() string
 	GetExpressions() abs.Sequential[ExpressionLike]
+	GetModes() abs.Sequential[ModeLike]
+	GetSpan() SpanLike
 }
 
 /*
@@ -712,6 +1247,7 @@ type TermLike interface {
 
 	// Attribute
 	GetAny() any
+	GetSpan() SpanLike
 }
 
 /*
@@ -725,4 +1261,52 @@ type TextLike interface {
 
 	// Attribute
 	GetAny() any
+	GetSpan() SpanLike
+}
+
+/*
+TransitionLike is an instance interface that defines the complete set of
+instance attributes, abstractions and methods that must be supported by each
+instance of a concrete transition-like class.
+*/
+type TransitionLike interface {
+	// Public
+	GetClass() TransitionClassLike
+
+	// Attribute
+	GetOptionalMode() string
+	GetSpan() SpanLike
+}
+
+/*
+PositionLike is an instance interface that defines the complete set of
+instance attributes, abstractions and methods that must be supported by each
+instance of a concrete position-like class.  Lines and columns are one based
+while the offset is a zero based index of a byte in the source.
+*/
+type PositionLike interface {
+	// Public
+	GetClass() PositionClassLike
+
+	// Attribute
+	GetLine() uint
+	GetColumn() uint
+	GetOffset() uint
+}
+
+/*
+SpanLike is an instance interface that defines the complete set of instance
+attributes, abstractions and methods that must be supported by each instance of
+a concrete span-like class.  The end position follows the last character in the
+span.  The source names the file containing the span when it is not the source
+that was parsed, e.g. a file that it imports.
+*/
+type SpanLike interface {
+	// Public
+	GetClass() SpanClassLike
+
+	// Attribute
+	GetSource() string
+	GetStart() PositionLike
+	GetEnd() PositionLike
 }
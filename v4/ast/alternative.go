@@ -54,6 +54,15 @@ func (c *alternativeClass_) Make(option OptionLike) AlternativeLike {
 	}
 }
 
+func (c *alternativeClass_) MakeWithSpan(
+	span SpanLike,
+	option OptionLike,
+) AlternativeLike {
+	var instance = c.Make(option).(*alternative_)
+	instance.span_ = span
+	return instance
+}
+
 // INSTANCE METHODS
 
 // Target
@@ -62,6 +71,7 @@ type alternative_ struct {
 	// Define instance attributes.
 	class_  AlternativeClassLike
 	option_ OptionLike
+	span_   SpanLike
 }
 
 // Attributes
@@ -74,4 +84,8 @@ func (v *alternative_) GetOption() OptionLike {
 	return v.option_
 }
 
+func (v *alternative_) GetSpan() SpanLike {
+	return v.span_
+}
+
 // Private
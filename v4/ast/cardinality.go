@@ -54,6 +54,15 @@ func (c *cardinalityClass_) Make(any_ any) CardinalityLike {
 	}
 }
 
+func (c *cardinalityClass_) MakeWithSpan(
+	span SpanLike,
+	any_ any,
+) CardinalityLike {
+	var instance = c.Make(any_).(*cardinality_)
+	instance.span_ = span
+	return instance
+}
+
 // INSTANCE METHODS
 
 // Target
@@ -62,6 +71,7 @@ type cardinality_ struct {
 	// Define instance attributes.
 	class_ CardinalityClassLike
 	any_   any
+	span_  SpanLike
 }
 
 // Attributes
@@ -74,4 +84,8 @@ func (v *cardinality_) GetAny() any {
 	return v.any_
 }
 
+func (v *cardinality_) GetSpan() SpanLike {
+	return v.span_
+}
+
 // Private
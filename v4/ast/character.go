@@ -54,6 +54,15 @@ func (c *characterClass_) Make(any_ any) CharacterLike {
 	}
 }
 
+func (c *characterClass_) MakeWithSpan(
+	span SpanLike,
+	any_ any,
+) CharacterLike {
+	var instance = c.Make(any_).(*character_)
+	instance.span_ = span
+	return instance
+}
+
 // INSTANCE METHODS
 
 // Target
@@ -62,6 +71,7 @@ type character_ struct {
 	// Define instance attributes.
 	class_ CharacterClassLike
 	any_   any
+	span_  SpanLike
 }
 
 // Attributes
@@ -74,4 +84,8 @@ func (v *character_) GetAny() any {
 	return v.any_
 }
 
+func (v *character_) GetSpan() SpanLike {
+	return v.span_
+}
+
 // Private
@@ -54,6 +54,15 @@ func (c *constrainedClass_) Make(any_ any) ConstrainedLike {
 	}
 }
 
+func (c *constrainedClass_) MakeWithSpan(
+	span SpanLike,
+	any_ any,
+) ConstrainedLike {
+	var instance = c.Make(any_).(*constrained_)
+	instance.span_ = span
+	return instance
+}
+
 // INSTANCE METHODS
 
 // Target
@@ -62,6 +71,7 @@ type constrained_ struct {
 	// Define instance attributes.
 	class_ ConstrainedClassLike
 	any_   any
+	span_  SpanLike
 }
 
 // Attributes
@@ -74,4 +84,8 @@ func (v *constrained_) GetAny() any {
 	return v.any_
 }
 
+func (v *constrained_) GetSpan() SpanLike {
+	return v.span_
+}
+
 // Private
@@ -54,6 +54,15 @@ func (c *definitionClass_) Make(any_ any) DefinitionLike {
 	}
 }
 
+func (c *definitionClass_) MakeWithSpan(
+	span SpanLike,
+	any_ any,
+) DefinitionLike {
+	var instance = c.Make(any_).(*definition_)
+	instance.span_ = span
+	return instance
+}
+
 // INSTANCE METHODS
 
 // Target
@@ -62,6 +71,7 @@ type definition_ struct {
 	// Define instance attributes.
 	class_ DefinitionClassLike
 	any_   any
+	span_  SpanLike
 }
 
 // Attributes
@@ -74,4 +84,8 @@ func (v *definition_) GetAny() any {
 	return v.any_
 }
 
+func (v *definition_) GetSpan() SpanLike {
+	return v.span_
+}
+
 // Private
@@ -0,0 +1,112 @@
+/*
+................................................................................
+.    Copyright (c) 2009-2024 Crater Dog Technologies.  All Rights Reserved.    .
+................................................................................
+.  DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               .
+.                                                                              .
+.  This code is free software; you can redistribute it and/or modify it under  .
+.  the terms of The MIT License (MIT), as published by the Open Source         .
+.  Initiative. (See https://opensource.org/license/MIT)                        .
+................................................................................
+*/
+
+package ast
+
+import (
+	col "github.com/craterdog/go-collection-framework/v4"
+	abs "github.com/craterdog/go-collection-framework/v4/collection"
+)
+
+// CLASS ACCESS
+
+// Reference
+
+var directiveClass = &directiveClass_{
+	// Initialize class constants.
+}
+
+// Function
+
+func Directive() DirectiveClassLike {
+	return directiveClass
+}
+
+// CLASS METHODS
+
+// Target
+
+type directiveClass_ struct {
+	// Define class constants.
+}
+
+// Constructors
+
+func (c *directiveClass_) Make(
+	lowercase string,
+	optionalNote string,
+	newlines abs.Sequential[string],
+) DirectiveLike {
+	// Validate the arguments.
+	switch {
+	case col.IsUndefined(lowercase):
+		panic("The lowercase attribute is required by this class.")
+	case col.IsUndefined(newlines):
+		panic("The newlines attribute is required by this class.")
+	default:
+		return &directive_{
+			// Initialize instance attributes.
+			class_:        c,
+			lowercase_:    lowercase,
+			optionalNote_: optionalNote,
+			newlines_:     newlines,
+		}
+	}
+}
+
+func (c *directiveClass_) MakeWithSpan(
+	span SpanLike,
+	lowercase string,
+	optionalNote string,
+	newlines abs.Sequential[string],
+) DirectiveLike {
+	var instance = c.Make(lowercase, optionalNote, newlines).(*directive_)
+	instance.span_ = span
+	return instance
+}
+
+// INSTANCE METHODS
+
+// Target
+
+type directive_ struct {
+	// Define instance attributes.
+	class_        DirectiveClassLike
+	lowercase_    string
+	optionalNote_ string
+	newlines_     abs.Sequential[string]
+	span_         SpanLike
+}
+
+// Attributes
+
+func (v *directive_) GetClass() DirectiveClassLike {
+	return v.class_
+}
+
+func (v *directive_) GetLowercase() string {
+	return v.lowercase_
+}
+
+func (v *directive_) GetOptionalNote() string {
+	return v.optionalNote_
+}
+
+func (v *directive_) GetNewlines() abs.Sequential[string] {
+	return v.newlines_
+}
+
+func (v *directive_) GetSpan() SpanLike {
+	return v.span_
+}
+
+// Private
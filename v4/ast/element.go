@@ -54,6 +54,15 @@ func (c *elementClass_) Make(any_ any) ElementLike {
 	}
 }
 
+func (c *elementClass_) MakeWithSpan(
+	span SpanLike,
+	any_ any,
+) ElementLike {
+	var instance = c.Make(any_).(*element_)
+	instance.span_ = span
+	return instance
+}
+
 // INSTANCE METHODS
 
 // Target
@@ -62,6 +71,7 @@ type element_ struct {
 	// Define instance attributes.
 	class_ ElementClassLike
 	any_   any
+	span_  SpanLike
 }
 
 // Attributes
@@ -74,4 +84,8 @@ func (v *element_) GetAny() any {
 	return v.any_
 }
 
+func (v *element_) GetSpan() SpanLike {
+	return v.span_
+}
+
 // Private
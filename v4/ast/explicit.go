@@ -58,6 +58,16 @@ func (c *explicitClass_) Make(
 	}
 }
 
+func (c *explicitClass_) MakeWithSpan(
+	span SpanLike,
+	glyph string,
+	optionalExtent ExtentLike,
+) ExplicitLike {
+	var instance = c.Make(glyph, optionalExtent).(*explicit_)
+	instance.span_ = span
+	return instance
+}
+
 // INSTANCE METHODS
 
 // Target
@@ -67,6 +77,7 @@ type explicit_ struct {
 	class_          ExplicitClassLike
 	glyph_          string
 	optionalExtent_ ExtentLike
+	span_           SpanLike
 }
 
 // Attributes
@@ -83,4 +94,8 @@ func (v *explicit_) GetOptionalExtent() ExtentLike {
 	return v.optionalExtent_
 }
 
+func (v *explicit_) GetSpan() SpanLike {
+	return v.span_
+}
+
 // Private
@@ -43,7 +43,9 @@ type expressionClass_ struct {
 
 func (c *expressionClass_) Make(
 	lowercase string,
+	optionalCaseless string,
 	pattern PatternLike,
+	optionalTransition TransitionLike,
 	optionalNote string,
 	newlines abs.Sequential[string],
 ) ExpressionLike {
@@ -58,26 +60,45 @@ func (c *expressionClass_) Make(
 	default:
 		return &expression_{
 			// Initialize instance attributes.
-			class_:        c,
-			lowercase_:    lowercase,
-			pattern_:      pattern,
-			optionalNote_: optionalNote,
-			newlines_:     newlines,
+			class_:              c,
+			lowercase_:          lowercase,
+			optionalCaseless_:   optionalCaseless,
+			pattern_:            pattern,
+			optionalTransition_: optionalTransition,
+			optionalNote_:       optionalNote,
+			newlines_:           newlines,
 		}
 	}
 }
 
+func (c *expressionClass_) MakeWithSpan(
+	span SpanLike,
+	lowercase string,
+	optionalCaseless string,
+	pattern PatternLike,
+	optionalTransition TransitionLike,
+	optionalNote string,
+	newlines abs.Sequential[string],
+) ExpressionLike {
+	var instance = c.Make(lowercase, optionalCaseless, pattern, optionalTransition, optionalNote, newlines).(*expression_)
+	instance.span_ = span
+	return instance
+}
+
 // INSTANCE METHODS
 
 // Target
 
 type expression_ struct {
 	// Define instance attributes.
-	class_        ExpressionClassLike
-	lowercase_    string
-	pattern_      PatternLike
-	optionalNote_ string
-	newlines_     abs.Sequential[string]
+	class_              ExpressionClassLike
+	lowercase_          string
+	optionalCaseless_   string
+	pattern_            PatternLike
+	optionalTransition_ TransitionLike
+	optionalNote_       string
+	newlines_           abs.Sequential[string]
+	span_               SpanLike
 }
 
 // Attributes
@@ -90,10 +111,18 @@ func (v *expression_) GetLowercase() string {
 	return v.lowercase_
 }
 
+func (v *expression_) GetOptionalCaseless() string {
+	return v.optionalCaseless_
+}
+
 func (v *expression_) GetPattern() PatternLike {
 	return v.pattern_
 }
 
+func (v *expression_) GetOptionalTransition() TransitionLike {
+	return v.optionalTransition_
+}
+
 func (v *expression_) GetOptionalNote() string {
 	return v.optionalNote_
 }
@@ -102,4 +131,8 @@ func (v *expression_) GetNewlines() abs.Sequential[string] {
 	return v.newlines_
 }
 
+func (v *expression_) GetSpan() SpanLike {
+	return v.span_
+}
+
 // Private
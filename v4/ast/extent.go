@@ -54,6 +54,15 @@ func (c *extentClass_) Make(glyph string) ExtentLike {
 	}
 }
 
+func (c *extentClass_) MakeWithSpan(
+	span SpanLike,
+	glyph string,
+) ExtentLike {
+	var instance = c.Make(glyph).(*extent_)
+	instance.span_ = span
+	return instance
+}
+
 // INSTANCE METHODS
 
 // Target
@@ -62,6 +71,7 @@ type extent_ struct {
 	// Define instance attributes.
 	class_ ExtentClassLike
 	glyph_ string
+	span_  SpanLike
 }
 
 // Attributes
@@ -74,4 +84,8 @@ func (v *extent_) GetGlyph() string {
 	return v.glyph_
 }
 
+func (v *extent_) GetSpan() SpanLike {
+	return v.span_
+}
+
 // Private
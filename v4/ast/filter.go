@@ -59,6 +59,16 @@ func (c *filterClass_) Make(
 	}
 }
 
+func (c *filterClass_) MakeWithSpan(
+	span SpanLike,
+	optionalExcluded string,
+	characters abs.Sequential[CharacterLike],
+) FilterLike {
+	var instance = c.Make(optionalExcluded, characters).(*filter_)
+	instance.span_ = span
+	return instance
+}
+
 // INSTANCE METHODS
 
 // Target
@@ -68,6 +78,7 @@ type filter_ struct {
 	class_            FilterClassLike
 	optionalExcluded_ string
 	characters_       abs.Sequential[CharacterLike]
+	span_             SpanLike
 }
 
 // Attributes
@@ -84,4 +95,8 @@ func (v *filter_) GetCharacters() abs.Sequential[CharacterLike] {
 	return v.characters_
 }
 
+func (v *filter_) GetSpan() SpanLike {
+	return v.span_
+}
+
 // Private
@@ -54,6 +54,15 @@ func (c *groupClass_) Make(pattern PatternLike) GroupLike {
 	}
 }
 
+func (c *groupClass_) MakeWithSpan(
+	span SpanLike,
+	pattern PatternLike,
+) GroupLike {
+	var instance = c.Make(pattern).(*group_)
+	instance.span_ = span
+	return instance
+}
+
 // INSTANCE METHODS
 
 // Target
@@ -62,6 +71,7 @@ type group_ struct {
 	// Define instance attributes.
 	class_   GroupClassLike
 	pattern_ PatternLike
+	span_    SpanLike
 }
 
 // Attributes
@@ -74,4 +84,8 @@ func (v *group_) GetPattern() PatternLike {
 	return v.pattern_
 }
 
+func (v *group_) GetSpan() SpanLike {
+	return v.span_
+}
+
 // Private
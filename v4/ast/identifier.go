@@ -54,6 +54,15 @@ func (c *identifierClass_) Make(any_ any) IdentifierLike {
 	}
 }
 
+func (c *identifierClass_) MakeWithSpan(
+	span SpanLike,
+	any_ any,
+) IdentifierLike {
+	var instance = c.Make(any_).(*identifier_)
+	instance.span_ = span
+	return instance
+}
+
 // INSTANCE METHODS
 
 // Target
@@ -62,6 +71,7 @@ type identifier_ struct {
 	// Define instance attributes.
 	class_ IdentifierClassLike
 	any_   any
+	span_  SpanLike
 }
 
 // Attributes
@@ -74,4 +84,8 @@ func (v *identifier_) GetAny() any {
 	return v.any_
 }
 
+func (v *identifier_) GetSpan() SpanLike {
+	return v.span_
+}
+
 // Private
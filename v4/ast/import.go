@@ -0,0 +1,112 @@
+/*
+................................................................................
+.    Copyright (c) 2009-2024 Crater Dog Technologies.  All Rights Reserved.    .
+................................................................................
+.  DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               .
+.                                                                              .
+.  This code is free software; you can redistribute it and/or modify it under  .
+.  the terms of The MIT License (MIT), as published by the Open Source         .
+.  Initiative. (See https://opensource.org/license/MIT)                        .
+................................................................................
+*/
+
+package ast
+
+import (
+	col "github.com/craterdog/go-collection-framework/v4"
+	abs "github.com/craterdog/go-collection-framework/v4/collection"
+)
+
+// CLASS ACCESS
+
+// Reference
+
+var importClass = &importClass_{
+	// Initialize class constants.
+}
+
+// Function
+
+func Import() ImportClassLike {
+	return importClass
+}
+
+// CLASS METHODS
+
+// Target
+
+type importClass_ struct {
+	// Define class constants.
+}
+
+// Constructors
+
+func (c *importClass_) Make(
+	literal string,
+	optionalNote string,
+	newlines abs.Sequential[string],
+) ImportLike {
+	// Validate the arguments.
+	switch {
+	case col.IsUndefined(literal):
+		panic("The literal attribute is required by this class.")
+	case col.IsUndefined(newlines):
+		panic("The newlines attribute is required by this class.")
+	default:
+		return &import_{
+			// Initialize instance attributes.
+			class_:        c,
+			literal_:      literal,
+			optionalNote_: optionalNote,
+			newlines_:     newlines,
+		}
+	}
+}
+
+func (c *importClass_) MakeWithSpan(
+	span SpanLike,
+	literal string,
+	optionalNote string,
+	newlines abs.Sequential[string],
+) ImportLike {
+	var instance = c.Make(literal, optionalNote, newlines).(*import_)
+	instance.span_ = span
+	return instance
+}
+
+// INSTANCE METHODS
+
+// Target
+
+type import_ struct {
+	// Define instance attributes.
+	class_        ImportClassLike
+	literal_      string
+	optionalNote_ string
+	newlines_     abs.Sequential[string]
+	span_         SpanLike
+}
+
+// Attributes
+
+func (v *import_) GetClass() ImportClassLike {
+	return v.class_
+}
+
+func (v *import_) GetLiteral() string {
+	return v.literal_
+}
+
+func (v *import_) GetOptionalNote() string {
+	return v.optionalNote_
+}
+
+func (v *import_) GetNewlines() abs.Sequential[string] {
+	return v.newlines_
+}
+
+func (v *import_) GetSpan() SpanLike {
+	return v.span_
+}
+
+// Private
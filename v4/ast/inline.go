@@ -59,6 +59,16 @@ func (c *inlineClass_) Make(
 	}
 }
 
+func (c *inlineClass_) MakeWithSpan(
+	span SpanLike,
+	terms abs.Sequential[TermLike],
+	optionalNote string,
+) InlineLike {
+	var instance = c.Make(terms, optionalNote).(*inline_)
+	instance.span_ = span
+	return instance
+}
+
 // INSTANCE METHODS
 
 // Target
@@ -68,6 +78,7 @@ type inline_ struct {
 	class_        InlineClassLike
 	terms_        abs.Sequential[TermLike]
 	optionalNote_ string
+	span_         SpanLike
 }
 
 // Attributes
@@ -84,4 +95,8 @@ func (v *inline_) GetOptionalNote() string {
 	return v.optionalNote_
 }
 
+func (v *inline_) GetSpan() SpanLike {
+	return v.span_
+}
+
 // Private
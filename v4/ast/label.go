@@ -0,0 +1,91 @@
+/*
+................................................................................
+.    Copyright (c) 2009-2024 Crater Dog Technologies.  All Rights Reserved.    .
+................................................................................
+.  DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               .
+.                                                                              .
+.  This code is free software; you can redistribute it and/or modify it under  .
+.  the terms of The MIT License (MIT), as published by the Open Source         .
+.  Initiative. (See https://opensource.org/license/MIT)                        .
+................................................................................
+*/
+
+package ast
+
+import (
+	col "github.com/craterdog/go-collection-framework/v4"
+)
+
+// CLASS ACCESS
+
+// Reference
+
+var labelClass = &labelClass_{
+	// Initialize class constants.
+}
+
+// Function
+
+func Label() LabelClassLike {
+	return labelClass
+}
+
+// CLASS METHODS
+
+// Target
+
+type labelClass_ struct {
+	// Define class constants.
+}
+
+// Constructors
+
+func (c *labelClass_) Make(lowercase string) LabelLike {
+	// Validate the arguments.
+	switch {
+	case col.IsUndefined(lowercase):
+		panic("The lowercase attribute is required by this class.")
+	default:
+		return &label_{
+			// Initialize instance attributes.
+			class_:     c,
+			lowercase_: lowercase,
+		}
+	}
+}
+
+func (c *labelClass_) MakeWithSpan(
+	span SpanLike,
+	lowercase string,
+) LabelLike {
+	var instance = c.Make(lowercase).(*label_)
+	instance.span_ = span
+	return instance
+}
+
+// INSTANCE METHODS
+
+// Target
+
+type label_ struct {
+	// Define instance attributes.
+	class_     LabelClassLike
+	lowercase_ string
+	span_      SpanLike
+}
+
+// Attributes
+
+func (v *label_) GetClass() LabelClassLike {
+	return v.class_
+}
+
+func (v *label_) GetLowercase() string {
+	return v.lowercase_
+}
+
+func (v *label_) GetSpan() SpanLike {
+	return v.span_
+}
+
+// Private
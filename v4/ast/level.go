@@ -0,0 +1,122 @@
+/*
+................................................................................
+.    Copyright (c) 2009-2024 Crater Dog Technologies.  All Rights Reserved.    .
+................................................................................
+.  DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               .
+.                                                                              .
+.  This code is free software; you can redistribute it and/or modify it under  .
+.  the terms of The MIT License (MIT), as published by the Open Source         .
+.  Initiative. (See https://opensource.org/license/MIT)                        .
+................................................................................
+*/
+
+package ast
+
+import (
+	col "github.com/craterdog/go-collection-framework/v4"
+	abs "github.com/craterdog/go-collection-framework/v4/collection"
+)
+
+// CLASS ACCESS
+
+// Reference
+
+var levelClass = &levelClass_{
+	// Initialize class constants.
+}
+
+// Function
+
+func Level() LevelClassLike {
+	return levelClass
+}
+
+// CLASS METHODS
+
+// Target
+
+type levelClass_ struct {
+	// Define class constants.
+}
+
+// Constructors
+
+func (c *levelClass_) Make(
+	associativity string,
+	operators abs.Sequential[OperatorLike],
+	optionalNote string,
+	newline string,
+) LevelLike {
+	// Validate the arguments.
+	switch {
+	case col.IsUndefined(associativity):
+		panic("The associativity attribute is required by this class.")
+	case col.IsUndefined(operators):
+		panic("The operators attribute is required by this class.")
+	case col.IsUndefined(newline):
+		panic("The newline attribute is required by this class.")
+	default:
+		return &level_{
+			// Initialize instance attributes.
+			class_:         c,
+			associativity_: associativity,
+			operators_:     operators,
+			optionalNote_:  optionalNote,
+			newline_:       newline,
+		}
+	}
+}
+
+func (c *levelClass_) MakeWithSpan(
+	span SpanLike,
+	associativity string,
+	operators abs.Sequential[OperatorLike],
+	optionalNote string,
+	newline string,
+) LevelLike {
+	var instance = c.Make(associativity, operators, optionalNote, newline).(*level_)
+	instance.span_ = span
+	return instance
+}
+
+// INSTANCE METHODS
+
+// Target
+
+type level_ struct {
+	// Define instance attributes.
+	class_         LevelClassLike
+	associativity_ string
+	operators_     abs.Sequential[OperatorLike]
+	optionalNote_  string
+	newline_       string
+	span_          SpanLike
+}
+
+// Attributes
+
+func (v *level_) GetClass() LevelClassLike {
+	return v.class_
+}
+
+func (v *level_) GetAssociativity() string {
+	return v.associativity_
+}
+
+func (v *level_) GetOperators() abs.Sequential[OperatorLike] {
+	return v.operators_
+}
+
+func (v *level_) GetOptionalNote() string {
+	return v.optionalNote_
+}
+
+func (v *level_) GetNewline() string {
+	return v.newline_
+}
+
+func (v *level_) GetSpan() SpanLike {
+	return v.span_
+}
+
+// Private
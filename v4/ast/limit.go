@@ -48,6 +48,15 @@ func (c *limitClass_) Make(optionalNumber string) LimitLike {
 	}
 }
 
+func (c *limitClass_) MakeWithSpan(
+	span SpanLike,
+	optionalNumber string,
+) LimitLike {
+	var instance = c.Make(optionalNumber).(*limit_)
+	instance.span_ = span
+	return instance
+}
+
 // INSTANCE METHODS
 
 // Target
@@ -56,6 +65,7 @@ type limit_ struct {
 	// Define instance attributes.
 	class_          LimitClassLike
 	optionalNumber_ string
+	span_           SpanLike
 }
 
 // Attributes
@@ -68,4 +78,8 @@ func (v *limit_) GetOptionalNumber() string {
 	return v.optionalNumber_
 }
 
+func (v *limit_) GetSpan() SpanLike {
+	return v.span_
+}
+
 // Private
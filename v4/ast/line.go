@@ -62,6 +62,17 @@ func (c *lineClass_) Make(
 	}
 }
 
+func (c *lineClass_) MakeWithSpan(
+	span SpanLike,
+	identifier IdentifierLike,
+	optionalNote string,
+	newline string,
+) LineLike {
+	var instance = c.Make(identifier, optionalNote, newline).(*line_)
+	instance.span_ = span
+	return instance
+}
+
 // INSTANCE METHODS
 
 // Target
@@ -72,6 +83,7 @@ type line_ struct {
 	identifier_   IdentifierLike
 	optionalNote_ string
 	newline_      string
+	span_         SpanLike
 }
 
 // Attributes
@@ -92,4 +104,8 @@ func (v *line_) GetNewline() string {
 	return v.newline_
 }
 
+func (v *line_) GetSpan() SpanLike {
+	return v.span_
+}
+
 // Private
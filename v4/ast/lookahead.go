@@ -0,0 +1,103 @@
+/*
+................................................................................
+.    Copyright (c) 2009-2024 Crater Dog Technologies.  All Rights Reserved.    .
+................................................................................
+.  DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               .
+.                                                                              .
+.  This code is free software; you can redistribute it and/or modify it under  .
+.  the terms of The MIT License (MIT), as published by the Open Source         .
+.  Initiative. (See https://opensource.org/license/MIT)                        .
+................................................................................
+*/
+
+package ast
+
+import (
+	col "github.com/craterdog/go-collection-framework/v4"
+)
+
+// CLASS ACCESS
+
+// Reference
+
+var lookaheadClass = &lookaheadClass_{
+	// Initialize class constants.
+}
+
+// Function
+
+func Lookahead() LookaheadClassLike {
+	return lookaheadClass
+}
+
+// CLASS METHODS
+
+// Target
+
+type lookaheadClass_ struct {
+	// Define class constants.
+}
+
+// Constructors
+
+func (c *lookaheadClass_) Make(
+	predicate string,
+	term TermLike,
+) LookaheadLike {
+	// Validate the arguments.
+	switch {
+	case col.IsUndefined(predicate):
+		panic("The predicate attribute is required by this class.")
+	case col.IsUndefined(term):
+		panic("The term attribute is required by this class.")
+	default:
+		return &lookahead_{
+			// Initialize instance attributes.
+			class_:     c,
+			predicate_: predicate,
+			term_:      term,
+		}
+	}
+}
+
+func (c *lookaheadClass_) MakeWithSpan(
+	span SpanLike,
+	predicate string,
+	term TermLike,
+) LookaheadLike {
+	var instance = c.Make(predicate, term).(*lookahead_)
+	instance.span_ = span
+	return instance
+}
+
+// INSTANCE METHODS
+
+// Target
+
+type lookahead_ struct {
+	// Define instance attributes.
+	class_     LookaheadClassLike
+	predicate_ string
+	term_      TermLike
+	span_      SpanLike
+}
+
+// Attributes
+
+func (v *lookahead_) GetClass() LookaheadClassLike {
+	return v.class_
+}
+
+func (v *lookahead_) GetPredicate() string {
+	return v.predicate_
+}
+
+func (v *lookahead_) GetTerm() TermLike {
+	return v.term_
+}
+
+func (v *lookahead_) GetSpan() SpanLike {
+	return v.span_
+}
+
+// Private
@@ -0,0 +1,122 @@
+/*
+................................................................................
+.    Copyright (c) 2009-2024 Crater Dog Technologies.  All Rights Reserved.    .
+................................................................................
+.  DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               .
+.                                                                              .
+.  This code is free software; you can redistribute it and/or modify it under  .
+.  the terms of The MIT License (MIT), as published by the Open Source         .
+.  Initiative. (See https://opensource.org/license/MIT)                        .
+................................................................................
+*/
+
+package ast
+
+import (
+	col "github.com/craterdog/go-collection-framework/v4"
+	abs "github.com/craterdog/go-collection-framework/v4/collection"
+)
+
+// CLASS ACCESS
+
+// Reference
+
+var modeClass = &modeClass_{
+	// Initialize class constants.
+}
+
+// Function
+
+func Mode() ModeClassLike {
+	return modeClass
+}
+
+// CLASS METHODS
+
+// Target
+
+type modeClass_ struct {
+	// Define class constants.
+}
+
+// Constructors
+
+func (c *modeClass_) Make(
+	lowercase string,
+	optionalNote string,
+	newlines abs.Sequential[string],
+	expressions abs.Sequential[ExpressionLike],
+) ModeLike {
+	// Validate the arguments.
+	switch {
+	case col.IsUndefined(lowercase):
+		panic("The lowercase attribute is required by this class.")
+	case col.IsUndefined(newlines):
+		panic("The newlines attribute is required by this class.")
+	case col.IsUndefined(expressions):
+		panic("The expressions attribute is required by this class.")
+	default:
+		return &mode_{
+			// Initialize instance attributes.
+			class_:        c,
+			lowercase_:    lowercase,
+			optionalNote_: optionalNote,
+			newlines_:     newlines,
+			expressions_:  expressions,
+		}
+	}
+}
+
+func (c *modeClass_) MakeWithSpan(
+	span SpanLike,
+	lowercase string,
+	optionalNote string,
+	newlines abs.Sequential[string],
+	expressions abs.Sequential[ExpressionLike],
+) ModeLike {
+	var instance = c.Make(lowercase, optionalNote, newlines, expressions).(*mode_)
+	instance.span_ = span
+	return instance
+}
+
+// INSTANCE METHODS
+
+// Target
+
+type mode_ struct {
+	// Define instance attributes.
+	class_        ModeClassLike
+	lowercase_    string
+	optionalNote_ string
+	newlines_     abs.Sequential[string]
+	expressions_  abs.Sequential[ExpressionLike]
+	span_         SpanLike
+}
+
+// Attributes
+
+func (v *mode_) GetClass() ModeClassLike {
+	return v.class_
+}
+
+func (v *mode_) GetLowercase() string {
+	return v.lowercase_
+}
+
+func (v *mode_) GetOptionalNote() string {
+	return v.optionalNote_
+}
+
+func (v *mode_) GetNewlines() abs.Sequential[string] {
+	return v.newlines_
+}
+
+func (v *mode_) GetExpressions() abs.Sequential[ExpressionLike] {
+	return v.expressions_
+}
+
+func (v *mode_) GetSpan() SpanLike {
+	return v.span_
+}
+
+// Private
@@ -61,6 +61,16 @@ func (c *multilineClass_) Make(
 	}
 }
 
+func (c *multilineClass_) MakeWithSpan(
+	span SpanLike,
+	newline string,
+	lines abs.Sequential[LineLike],
+) MultilineLike {
+	var instance = c.Make(newline, lines).(*multiline_)
+	instance.span_ = span
+	return instance
+}
+
 // INSTANCE METHODS
 
 // Target
@@ -70,6 +80,7 @@ type multiline_ struct {
 	class_   MultilineClassLike
 	newline_ string
 	lines_   abs.Sequential[LineLike]
+	span_    SpanLike
 }
 
 // Attributes
@@ -86,4 +97,8 @@ func (v *multiline_) GetLines() abs.Sequential[LineLike] {
 	return v.lines_
 }
 
+func (v *multiline_) GetSpan() SpanLike {
+	return v.span_
+}
+
 // Private
@@ -60,6 +60,16 @@ func (c *noticeClass_) Make(
 	}
 }
 
+func (c *noticeClass_) MakeWithSpan(
+	span SpanLike,
+	comment string,
+	newline string,
+) NoticeLike {
+	var instance = c.Make(comment, newline).(*notice_)
+	instance.span_ = span
+	return instance
+}
+
 // INSTANCE METHODS
 
 // Target
@@ -69,6 +79,7 @@ type notice_ struct {
 	class_   NoticeClassLike
 	comment_ string
 	newline_ string
+	span_    SpanLike
 }
 
 // Attributes
@@ -85,4 +96,8 @@ func (v *notice_) GetNewline() string {
 	return v.newline_
 }
 
+func (v *notice_) GetSpan() SpanLike {
+	return v.span_
+}
+
 // Private
@@ -0,0 +1,91 @@
+/*
+................................................................................
+.    Copyright (c) 2009-2024 Crater Dog Technologies.  All Rights Reserved.    .
+................................................................................
+.  DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               .
+.                                                                              .
+.  This code is free software; you can redistribute it and/or modify it under  .
+.  the terms of The MIT License (MIT), as published by the Open Source         .
+.  Initiative. (See https://opensource.org/license/MIT)                        .
+................................................................................
+*/
+
+package ast
+
+import (
+	col "github.com/craterdog/go-collection-framework/v4"
+)
+
+// CLASS ACCESS
+
+// Reference
+
+var operatorClass = &operatorClass_{
+	// Initialize class constants.
+}
+
+// Function
+
+func Operator() OperatorClassLike {
+	return operatorClass
+}
+
+// CLASS METHODS
+
+// Target
+
+type operatorClass_ struct {
+	// Define class constants.
+}
+
+// Constructors
+
+func (c *operatorClass_) Make(literal string) OperatorLike {
+	// Validate the arguments.
+	switch {
+	case col.IsUndefined(literal):
+		panic("The literal attribute is required by this class.")
+	default:
+		return &operator_{
+			// Initialize instance attributes.
+			class_:   c,
+			literal_: literal,
+		}
+	}
+}
+
+func (c *operatorClass_) MakeWithSpan(
+	span SpanLike,
+	literal string,
+) OperatorLike {
+	var instance = c.Make(literal).(*operator_)
+	instance.span_ = span
+	return instance
+}
+
+// INSTANCE METHODS
+
+// Target
+
+type operator_ struct {
+	// Define instance attributes.
+	class_   OperatorClassLike
+	literal_ string
+	span_    SpanLike
+}
+
+// Attributes
+
+func (v *operator_) GetClass() OperatorClassLike {
+	return v.class_
+}
+
+func (v *operator_) GetLiteral() string {
+	return v.literal_
+}
+
+func (v *operator_) GetSpan() SpanLike {
+	return v.span_
+}
+
+// Private
@@ -55,6 +55,15 @@ func (c *optionClass_) Make(repetitions abs.Sequential[RepetitionLike]) OptionLi
 	}
 }
 
+func (c *optionClass_) MakeWithSpan(
+	span SpanLike,
+	repetitions abs.Sequential[RepetitionLike],
+) OptionLike {
+	var instance = c.Make(repetitions).(*option_)
+	instance.span_ = span
+	return instance
+}
+
 // INSTANCE METHODS
 
 // Target
@@ -63,6 +72,7 @@ type option_ struct {
 	// Define instance attributes.
 	class_       OptionClassLike
 	repetitions_ abs.Sequential[RepetitionLike]
+	span_        SpanLike
 }
 
 // Attributes
@@ -75,4 +85,8 @@ func (v *option_) GetRepetitions() abs.Sequential[RepetitionLike] {
 	return v.repetitions_
 }
 
+func (v *option_) GetSpan() SpanLike {
+	return v.span_
+}
+
 // Private
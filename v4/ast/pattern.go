@@ -61,6 +61,16 @@ func (c *patternClass_) Make(
 	}
 }
 
+func (c *patternClass_) MakeWithSpan(
+	span SpanLike,
+	option OptionLike,
+	alternatives abs.Sequential[AlternativeLike],
+) PatternLike {
+	var instance = c.Make(option, alternatives).(*pattern_)
+	instance.span_ = span
+	return instance
+}
+
 // INSTANCE METHODS
 
 // Target
@@ -70,6 +80,7 @@ type pattern_ struct {
 	class_        PatternClassLike
 	option_       OptionLike
 	alternatives_ abs.Sequential[AlternativeLike]
+	span_         SpanLike
 }
 
 // Attributes
@@ -86,4 +97,8 @@ func (v *pattern_) GetAlternatives() abs.Sequential[AlternativeLike] {
 	return v.alternatives_
 }
 
+func (v *pattern_) GetSpan() SpanLike {
+	return v.span_
+}
+
 // Private
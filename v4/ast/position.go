@@ -0,0 +1,91 @@
+/*
+................................................................................
+.    Copyright (c) 2009-2024 Crater Dog Technologies.  All Rights Reserved.    .
+................................................................................
+.  DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               .
+.                                                                              .
+.  This code is free software; you can redistribute it and/or modify it under  .
+.  the terms of The MIT License (MIT), as published by the Open Source         .
+.  Initiative. (See https://opensource.org/license/MIT)                        .
+................................................................................
+*/
+
+package ast
+
+// CLASS ACCESS
+
+// Reference
+
+var positionClass = &positionClass_{
+	// Initialize class constants.
+}
+
+// Function
+
+func Position() PositionClassLike {
+	return positionClass
+}
+
+// CLASS METHODS
+
+// Target
+
+type positionClass_ struct {
+	// Define class constants.
+}
+
+// Constructors
+
+func (c *positionClass_) Make(
+	line uint,
+	column uint,
+	offset uint,
+) PositionLike {
+	// Validate the arguments.
+	switch {
+	case line == 0:
+		panic("The line attribute must be at least one.")
+	case column == 0:
+		panic("The column attribute must be at least one.")
+	default:
+		return &position_{
+			// Initialize instance attributes.
+			class_:  c,
+			line_:   line,
+			column_: column,
+			offset_: offset,
+		}
+	}
+}
+
+// INSTANCE METHODS
+
+// Target
+
+type position_ struct {
+	// Define instance attributes.
+	class_  PositionClassLike
+	line_   uint
+	column_ uint
+	offset_ uint
+}
+
+// Attributes
+
+func (v *position_) GetClass() PositionClassLike {
+	return v.class_
+}
+
+func (v *position_) GetLine() uint {
+	return v.line_
+}
+
+func (v *position_) GetColumn() uint {
+	return v.column_
+}
+
+func (v *position_) GetOffset() uint {
+	return v.offset_
+}
+
+// Private
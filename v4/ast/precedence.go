@@ -0,0 +1,122 @@
+/*
+................................................................................
+.    Copyright (c) 2009-2024 Crater Dog Technologies.  All Rights Reserved.    .
+................................................................................
+.  DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               .
+.                                                                              .
+.  This code is free software; you can redistribute it and/or modify it under  .
+.  the terms of The MIT License (MIT), as published by the Open Source         .
+.  Initiative. (See https://opensource.org/license/MIT)                        .
+................................................................................
+*/
+
+package ast
+
+import (
+	col "github.com/craterdog/go-collection-framework/v4"
+	abs "github.com/craterdog/go-collection-framework/v4/collection"
+)
+
+// CLASS ACCESS
+
+// Reference
+
+var precedenceClass = &precedenceClass_{
+	// Initialize class constants.
+}
+
+// Function
+
+func Precedence() PrecedenceClassLike {
+	return precedenceClass
+}
+
+// CLASS METHODS
+
+// Target
+
+type precedenceClass_ struct {
+	// Define class constants.
+}
+
+// Constructors
+
+func (c *precedenceClass_) Make(
+	identifier IdentifierLike,
+	optionalNote string,
+	newline string,
+	levels abs.Sequential[LevelLike],
+) PrecedenceLike {
+	// Validate the arguments.
+	switch {
+	case col.IsUndefined(identifier):
+		panic("The identifier attribute is required by this class.")
+	case col.IsUndefined(newline):
+		panic("The newline attribute is required by this class.")
+	case col.IsUndefined(levels):
+		panic("The levels attribute is required by this class.")
+	default:
+		return &precedence_{
+			// Initialize instance attributes.
+			class_:        c,
+			identifier_:   identifier,
+			optionalNote_: optionalNote,
+			newline_:      newline,
+			levels_:       levels,
+		}
+	}
+}
+
+func (c *precedenceClass_) MakeWithSpan(
+	span SpanLike,
+	identifier IdentifierLike,
+	optionalNote string,
+	newline string,
+	levels abs.Sequential[LevelLike],
+) PrecedenceLike {
+	var instance = c.Make(identifier, optionalNote, newline, levels).(*precedence_)
+	instance.span_ = span
+	return instance
+}
+
+// INSTANCE METHODS
+
+// Target
+
+type precedence_ struct {
+	// Define instance attributes.
+	class_        PrecedenceClassLike
+	identifier_   IdentifierLike
+	optionalNote_ string
+	newline_      string
+	levels_       abs.Sequential[LevelLike]
+	span_         SpanLike
+}
+
+// Attributes
+
+func (v *precedence_) GetClass() PrecedenceClassLike {
+	return v.class_
+}
+
+func (v *precedence_) GetIdentifier() IdentifierLike {
+	return v.identifier_
+}
+
+func (v *precedence_) GetOptionalNote() string {
+	return v.optionalNote_
+}
+
+func (v *precedence_) GetNewline() string {
+	return v.newline_
+}
+
+func (v *precedence_) GetLevels() abs.Sequential[LevelLike] {
+	return v.levels_
+}
+
+func (v *precedence_) GetSpan() SpanLike {
+	return v.span_
+}
+
+// Private
@@ -58,6 +58,16 @@ func (c *quantifiedClass_) Make(
 	}
 }
 
+func (c *quantifiedClass_) MakeWithSpan(
+	span SpanLike,
+	number string,
+	optionalLimit LimitLike,
+) QuantifiedLike {
+	var instance = c.Make(number, optionalLimit).(*quantified_)
+	instance.span_ = span
+	return instance
+}
+
 // INSTANCE METHODS
 
 // Target
@@ -67,6 +77,7 @@ type quantified_ struct {
 	class_         QuantifiedClassLike
 	number_        string
 	optionalLimit_ LimitLike
+	span_          SpanLike
 }
 
 // Attributes
@@ -83,4 +94,8 @@ func (v *quantified_) GetOptionalLimit() LimitLike {
 	return v.optionalLimit_
 }
 
+func (v *quantified_) GetSpan() SpanLike {
+	return v.span_
+}
+
 // Private
@@ -41,8 +41,10 @@ type referenceClass_ struct {
 // Constructors
 
 func (c *referenceClass_) Make(
+	optionalLabel LabelLike,
 	identifier IdentifierLike,
 	optionalCardinality CardinalityLike,
+	optionalSeparator SeparatorLike,
 ) ReferenceLike {
 	// Validate the arguments.
 	switch {
@@ -52,12 +54,26 @@ func (c *referenceClass_) Make(
 		return &reference_{
 			// Initialize instance attributes.
 			class_:               c,
+			optionalLabel_:       optionalLabel,
 			identifier_:          identifier,
 			optionalCardinality_: optionalCardinality,
+			optionalSeparator_:   optionalSeparator,
 		}
 	}
 }
 
+func (c *referenceClass_) MakeWithSpan(
+	span SpanLike,
+	optionalLabel LabelLike,
+	identifier IdentifierLike,
+	optionalCardinality CardinalityLike,
+	optionalSeparator SeparatorLike,
+) ReferenceLike {
+	var instance = c.Make(optionalLabel, identifier, optionalCardinality, optionalSeparator).(*reference_)
+	instance.span_ = span
+	return instance
+}
+
 // INSTANCE METHODS
 
 // Target
@@ -65,8 +81,11 @@ func (c *referenceClass_) Make(
 type reference_ struct {
 	// Define instance attributes.
 	class_               ReferenceClassLike
+	optionalLabel_       LabelLike
 	identifier_          IdentifierLike
 	optionalCardinality_ CardinalityLike
+	optionalSeparator_   SeparatorLike
+	span_                SpanLike
 }
 
 // Attributes
@@ -75,6 +94,10 @@ func (v *reference_) GetClass() ReferenceClassLike {
 	return v.class_
 }
 
+func (v *reference_) GetOptionalLabel() LabelLike {
+	return v.optionalLabel_
+}
+
 func (v *reference_) GetIdentifier() IdentifierLike {
 	return v.identifier_
 }
@@ -83,4 +106,12 @@ func (v *reference_) GetOptionalCardinality() CardinalityLike {
 	return v.optionalCardinality_
 }
 
+func (v *reference_) GetOptionalSeparator() SeparatorLike {
+	return v.optionalSeparator_
+}
+
+func (v *reference_) GetSpan() SpanLike {
+	return v.span_
+}
+
 // Private
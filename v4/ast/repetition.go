@@ -58,6 +58,16 @@ func (c *repetitionClass_) Make(
 	}
 }
 
+func (c *repetitionClass_) MakeWithSpan(
+	span SpanLike,
+	element ElementLike,
+	optionalCardinality CardinalityLike,
+) RepetitionLike {
+	var instance = c.Make(element, optionalCardinality).(*repetition_)
+	instance.span_ = span
+	return instance
+}
+
 // INSTANCE METHODS
 
 // Target
@@ -67,6 +77,7 @@ type repetition_ struct {
 	class_               RepetitionClassLike
 	element_             ElementLike
 	optionalCardinality_ CardinalityLike
+	span_                SpanLike
 }
 
 // Attributes
@@ -83,4 +94,8 @@ func (v *repetition_) GetOptionalCardinality() CardinalityLike {
 	return v.optionalCardinality_
 }
 
+func (v *repetition_) GetSpan() SpanLike {
+	return v.span_
+}
+
 // Private
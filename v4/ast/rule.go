@@ -65,6 +65,17 @@ func (c *ruleClass_) Make(
 	}
 }
 
+func (c *ruleClass_) MakeWithSpan(
+	span SpanLike,
+	uppercase string,
+	definition DefinitionLike,
+	newlines abs.Sequential[string],
+) RuleLike {
+	var instance = c.Make(uppercase, definition, newlines).(*rule_)
+	instance.span_ = span
+	return instance
+}
+
 // INSTANCE METHODS
 
 // Target
@@ -75,6 +86,7 @@ type rule_ struct {
 	uppercase_  string
 	definition_ DefinitionLike
 	newlines_   abs.Sequential[string]
+	span_       SpanLike
 }
 
 // Attributes
@@ -95,4 +107,8 @@ func (v *rule_) GetNewlines() abs.Sequential[string] {
 	return v.newlines_
 }
 
+func (v *rule_) GetSpan() SpanLike {
+	return v.span_
+}
+
 // Private
@@ -0,0 +1,101 @@
+/*
+................................................................................
+.    Copyright (c) 2009-2024 Crater Dog Technologies.  All Rights Reserved.    .
+................................................................................
+.  DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               .
+.                                                                              .
+.  This code is free software; you can redistribute it and/or modify it under  .
+.  the terms of The MIT License (MIT), as published by the Open Source         .
+.  Initiative. (See https://opensource.org/license/MIT)                        .
+................................................................................
+*/
+
+package ast
+
+import (
+	col "github.com/craterdog/go-collection-framework/v4"
+)
+
+// CLASS ACCESS
+
+// Reference
+
+var separatorClass = &separatorClass_{
+	// Initialize class constants.
+}
+
+// Function
+
+func Separator() SeparatorClassLike {
+	return separatorClass
+}
+
+// CLASS METHODS
+
+// Target
+
+type separatorClass_ struct {
+	// Define class constants.
+}
+
+// Constructors
+
+func (c *separatorClass_) Make(
+	literal string,
+	optionalTrailing string,
+) SeparatorLike {
+	// Validate the arguments.
+	switch {
+	case col.IsUndefined(literal):
+		panic("The literal attribute is required by this class.")
+	default:
+		return &separator_{
+			// Initialize instance attributes.
+			class_:            c,
+			literal_:          literal,
+			optionalTrailing_: optionalTrailing,
+		}
+	}
+}
+
+func (c *separatorClass_) MakeWithSpan(
+	span SpanLike,
+	literal string,
+	optionalTrailing string,
+) SeparatorLike {
+	var instance = c.Make(literal, optionalTrailing).(*separator_)
+	instance.span_ = span
+	return instance
+}
+
+// INSTANCE METHODS
+
+// Target
+
+type separator_ struct {
+	// Define instance attributes.
+	class_            SeparatorClassLike
+	literal_          string
+	optionalTrailing_ string
+	span_             SpanLike
+}
+
+// Attributes
+
+func (v *separator_) GetClass() SeparatorClassLike {
+	return v.class_
+}
+
+func (v *separator_) GetLiteral() string {
+	return v.literal_
+}
+
+func (v *separator_) GetOptionalTrailing() string {
+	return v.optionalTrailing_
+}
+
+func (v *separator_) GetSpan() SpanLike {
+	return v.span_
+}
+
+// Private
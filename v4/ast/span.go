@@ -0,0 +1,103 @@
+/*
+................................................................................
+.    Copyright (c) 2009-2024 Crater Dog Technologies.  All Rights Reserved.    .
+................................................................................
+.  DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               .
+.                                                                              .
+.  This code is free software; you can redistribute it and/or modify it under  .
+.  the terms of The MIT License (MIT), as published by the Open Source         .
+.  Initiative. (See https://opensource.org/license/MIT)                        .
+................................................................................
+*/
+
+package ast
+
+import (
+	col "github.com/craterdog/go-collection-framework/v4"
+)
+
+// CLASS ACCESS
+
+// Reference
+
+var spanClass = &spanClass_{
+	// Initialize class constants.
+}
+
+// Function
+
+func Span() SpanClassLike {
+	return spanClass
+}
+
+// CLASS METHODS
+
+// Target
+
+type spanClass_ struct {
+	// Define class constants.
+}
+
+// Constructors
+
+func (c *spanClass_) Make(
+	start PositionLike,
+	end PositionLike,
+) SpanLike {
+	// Validate the arguments.
+	switch {
+	case col.IsUndefined(start):
+		panic("The start attribute is required by this class.")
+	case col.IsUndefined(end):
+		panic("The end attribute is required by this class.")
+	default:
+		return &span_{
+			// Initialize instance attributes.
+			class_: c,
+			start_: start,
+			end_:   end,
+		}
+	}
+}
+
+func (c *spanClass_) MakeWithSource(
+	source string,
+	start PositionLike,
+	end PositionLike,
+) SpanLike {
+	var instance = c.Make(start, end).(*span_)
+	instance.source_ = source
+	return instance
+}
+
+// INSTANCE METHODS
+
+// Target
+
+type span_ struct {
+	// Define instance attributes.
+	class_  SpanClassLike
+	source_ string
+	start_  PositionLike
+	end_    PositionLike
+}
+
+// Attributes
+
+func (v *span_) GetClass() SpanClassLike {
+	return v.class_
+}
+
+func (v *span_) GetSource() string {
+	return v.source_
+}
+
+func (v *span_) GetStart() PositionLike {
+	return v.start_
+}
+
+func (v *span_) GetEnd() PositionLike {
+	return v.end_
+}
+
+// Private
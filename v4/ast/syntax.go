@@ -43,48 +43,84 @@ type syntaxClass_ struct {
 
 func (c *syntaxClass_) Make(
 	notice NoticeLike,
-	comment1 string,
+	directives abs.Sequential[DirectiveLike],
+	imports abs.Sequential[ImportLike],
+	ruleHeader string,
+	optionalNewline string,
 	rules abs.Sequential[RuleLike],
-	comment2 string,
+	expressionHeader string,
 	expressions abs.Sequential[ExpressionLike],
+	modes abs.Sequential[ModeLike],
 ) SyntaxLike {
 	// Validate the arguments.
 	switch {
 	case col.IsUndefined(notice):
 		panic("The notice attribute is required by this class.")
-	case col.IsUndefined(comment1):
-		panic("The comment1 attribute is required by this class.")
+	case col.IsUndefined(directives):
+		panic("The directives attribute is required by this class.")
+	case col.IsUndefined(imports):
+		panic("The imports attribute is required by this class.")
+	case col.IsUndefined(ruleHeader):
+		panic("The ruleHeader attribute is required by this class.")
 	case col.IsUndefined(rules):
 		panic("The rules attribute is required by this class.")
-	case col.IsUndefined(comment2):
-		panic("The comment2 attribute is required by this class.")
+	case col.IsUndefined(expressionHeader):
+		panic("The expressionHeader attribute is required by this class.")
 	case col.IsUndefined(expressions):
 		panic("The expressions attribute is required by this class.")
+	case col.IsUndefined(modes):
+		panic("The modes attribute is required by this class.")
 	default:
 		return &syntax_{
 			// Initialize instance attributes.
-			class_:       c,
-			notice_:      notice,
-			comment1_:    comment1,
-			rules_:       rules,
-			comment2_:    comment2,
-			expressions_: expressions,
+			class_:            c,
+			notice_:           notice,
+			directives_:       directives,
+			imports_:          imports,
+			ruleHeader_:       ruleHeader,
+			optionalNewline_:  optionalNewline,
+			rules_:            rules,
+			expressionHeader_: expressionHeader,
+			expressions_:      expressions,
+			modes_:            modes,
 		}
 	}
 }
 
+func (c *syntaxClass_) MakeWithSpan(
+	span SpanLike,
+	notice NoticeLike,
+	directives abs.Sequential[DirectiveLike],
+	imports abs.Sequential[ImportLike],
+	ruleHeader string,
+	optionalNewline string,
+	rules abs.Sequential[RuleLike],
+	expressionHeader string,
+	expressions abs.Sequential[ExpressionLike],
+	modes abs.Sequential[ModeLike],
+) SyntaxLike {
+	var instance = c.Make(notice, directives, imports, ruleHeader, optionalNewline, rules, expressionHeader, expressions, modes).(*syntax_)
+	instance.span_ = span
+	return instance
+}
+
 // INSTANCE METHODS
 
 // Target
 
 type syntax_ struct {
 	// Define instance attributes.
-	class_       SyntaxClassLike
-	notice_      NoticeLike
-	comment1_    string
-	rules_       abs.Sequential[RuleLike]
-	comment2_    string
-	expressions_ abs.Sequential[ExpressionLike]
+	class_            SyntaxClassLike
+	notice_           NoticeLike
+	directives_       abs.Sequential[DirectiveLike]
+	imports_          abs.Sequential[ImportLike]
+	ruleHeader_       string
+	optionalNewline_  string
+	rules_            abs.Sequential[RuleLike]
+	expressionHeader_ string
+	expressions_      abs.Sequential[ExpressionLike]
+	modes_            abs.Sequential[ModeLike]
+	span_             SpanLike
 }
 
 // Attributes
@@ -97,20 +133,40 @@ func (v *syntax_) GetNotice() NoticeLike {
 	return v.notice_
 }
 
-func (v *syntax_) GetComment1() string {
-	return v.comment1_
+func (v *syntax_) GetDirectives() abs.Sequential[DirectiveLike] {
+	return v.directives_
+}
+
+func (v *syntax_) GetImports() abs.Sequential[ImportLike] {
+	return v.imports_
+}
+
+func (v *syntax_) GetRuleHeader() string {
+	return v.ruleHeader_
+}
+
+func (v *syntax_) GetOptionalNewline() string {
+	return v.optionalNewline_
 }
 
 func (v *syntax_) GetRules() abs.Sequential[RuleLike] {
 	return v.rules_
 }
 
-func (v *syntax_) GetComment2() string {
-	return v.comment2_
+func (v *syntax_) GetExpressionHeader() string {
+	return v.expressionHeader_
 }
 
 func (v *syntax_) GetExpressions() abs.Sequential[ExpressionLike] {
 	return v.expressions_
 }
 
+func (v *syntax_) GetModes() abs.Sequential[ModeLike] {
+	return v.modes_
+}
+
+func (v *syntax_) GetSpan() SpanLike {
+	return v.span_
+}
+
 // Private
@@ -54,6 +54,15 @@ func (c *termClass_) Make(any_ any) TermLike {
 	}
 }
 
+func (c *termClass_) MakeWithSpan(
+	span SpanLike,
+	any_ any,
+) TermLike {
+	var instance = c.Make(any_).(*term_)
+	instance.span_ = span
+	return instance
+}
+
 // INSTANCE METHODS
 
 // Target
@@ -62,6 +71,7 @@ type term_ struct {
 	// Define instance attributes.
 	class_ TermClassLike
 	any_   any
+	span_  SpanLike
 }
 
 // Attributes
@@ -74,4 +84,8 @@ func (v *term_) GetAny() any {
 	return v.any_
 }
 
+func (v *term_) GetSpan() SpanLike {
+	return v.span_
+}
+
 // Private
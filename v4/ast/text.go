@@ -54,6 +54,15 @@ func (c *textClass_) Make(any_ any) TextLike {
 	}
 }
 
+func (c *textClass_) MakeWithSpan(
+	span SpanLike,
+	any_ any,
+) TextLike {
+	var instance = c.Make(any_).(*text_)
+	instance.span_ = span
+	return instance
+}
+
 // INSTANCE METHODS
 
 // Target
@@ -62,6 +71,7 @@ type text_ struct {
 	// Define instance attributes.
 	class_ TextClassLike
 	any_   any
+	span_  SpanLike
 }
 
 // Attributes
@@ -74,4 +84,8 @@ func (v *text_) GetAny() any {
 	return v.any_
 }
 
+func (v *text_) GetSpan() SpanLike {
+	return v.span_
+}
+
 // Private
@@ -0,0 +1,85 @@
+/*
+................................................................................
+.    Copyright (c) 2009-2024 Crater Dog Technologies.  All Rights Reserved.    .
+................................................................................
+.  DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               .
+.                                                                              .
+.  This code is free software; you can redistribute it and/or modify it under  .
+.  the terms of The MIT License (MIT), as published by the Open Source         .
+.  Initiative. (See https://opensource.org/license/MIT)                        .
+................................................................................
+*/
+
+package ast
+
+// CLASS ACCESS
+
+// Reference
+
+var transitionClass = &transitionClass_{
+	// Initialize class constants.
+}
+
+// Function
+
+func Transition() TransitionClassLike {
+	return transitionClass
+}
+
+// CLASS METHODS
+
+// Target
+
+type transitionClass_ struct {
+	// Define class constants.
+}
+
+// Constructors
+
+func (c *transitionClass_) Make(optionalMode string) TransitionLike {
+	// Validate the arguments.
+	switch {
+	default:
+		return &transition_{
+			// Initialize instance attributes.
+			class_:        c,
+			optionalMode_: optionalMode,
+		}
+	}
+}
+
+func (c *transitionClass_) MakeWithSpan(
+	span SpanLike,
+	optionalMode string,
+) TransitionLike {
+	var instance = c.Make(optionalMode).(*transition_)
+	instance.span_ = span
+	return instance
+}
+
+// INSTANCE METHODS
+
+// Target
+
+type transition_ struct {
+	// Define instance attributes.
+	class_        TransitionClassLike
+	optionalMode_ string
+	span_         SpanLike
+}
+
+// Attributes
+
+func (v *transition_) GetClass() TransitionClassLike {
+	return v.class_
+}
+
+func (v *transition_) GetOptionalMode() string {
+	return v.optionalMode_
+}
+
+func (v *transition_) GetSpan() SpanLike {
+	return v.span_
+}
+
+// Private
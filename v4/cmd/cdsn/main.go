@@ -0,0 +1,344 @@
+/*
+................................................................................
+.    Copyright (c) 2009-2024 Crater Dog Technologies.  All Rights Reserved.    .
+................................................................................
+.  DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               .
+.                                                                              .
+.  This code is free software; you can redistribute it and/or modify it under  .
+.  the terms of The MIT License (MIT), as published by the Open Source         .
+.  Initiative. (See https://opensource.org/license/MIT)                        .
+................................................................................
+*/
+
+/*
+The "cdsn" command provides the common workflows for a language defined using
+Crater Dog Syntax Notation™ (CDSN):
+
+	cdsn validate <syntax>...
+	cdsn format [-check] <syntax>...
+	cdsn generate [-check] -module <path> [-wiki <url>] [-directory <dir>]
+		[-templates <dir>] <syntax>
+	cdsn new [-copyright <text>] <name>
+	cdsn tokens <syntax>
+
+The command exits with a status of zero on success, one if any syntax file has
+problems—or is not formatted, or its generated code is stale, when checked—and
+two if the command line itself is invalid.  This allows it to be used by build
+pipelines and "go:generate" directives, e.g.
+
+	//go:generate go run github.com/craterdog/go-grammar-framework/v4/cmd/cdsn generate -module example.com/language Syntax.cdsn
+
+Any warnings about a valid syntax, e.g. an alternative that can never be
+selected, are reported by the validate command without failing it.
+
+Any top-level declaration in a generated file whose doc comment contains the
+"//cdsn:preserve" directive is kept when the module is regenerated.
+*/
+package main
+
+import (
+	flg "flag"
+	fmt "fmt"
+	col "github.com/craterdog/go-collection-framework/v4"
+	abs "github.com/craterdog/go-collection-framework/v4/collection"
+	gra "github.com/craterdog/go-grammar-framework/v4"
+	iox "io"
+	osx "os"
+	fil "path/filepath"
+	reg "regexp"
+	sts "strings"
+)
+
+func main() {
+	osx.Exit(run(osx.Args[1:], osx.Stdout, osx.Stderr))
+}
+
+// Functions
+
+func run(arguments []string, output iox.Writer, errors iox.Writer) int {
+	if len(arguments) == 0 {
+		fmt.Fprint(errors, usage_)
+		return usageError_
+	}
+	var command = commands_[arguments[0]]
+	if command == nil {
+		fmt.Fprintf(errors, "The command %q is not supported.\n%v", arguments[0], usage_)
+		return usageError_
+	}
+	return command(arguments[1:], output, errors)
+}
+
+func formatSyntaxes(arguments []string, output iox.Writer, errors iox.Writer) int {
+	var flags = makeFlags("format", errors)
+	var check = flags.Bool("check", false, "report unformatted files instead of rewriting them")
+	if !parseFlags(flags, arguments, false) {
+		return usageError_
+	}
+	var status = success_
+	for _, filename := range flags.Args() {
+		var source, syntax = parseSyntax(filename, errors)
+		if syntax == nil {
+			status = failure_
+			continue
+		}
+		var formatted = gra.FormatSyntax(syntax)
+		if formatted == source {
+			continue
+		}
+		if *check {
+			fmt.Fprintf(errors, "%v: The syntax is not formatted.\n", filename)
+			status = failure_
+			continue
+		}
+		var err = osx.WriteFile(filename, []byte(formatted), 0644)
+		if err != nil {
+			fmt.Fprintf(errors, "%v: %v\n", filename, err)
+			status = failure_
+			continue
+		}
+		fmt.Fprintln(output, filename)
+	}
+	return status
+}
+
+func generateModule(arguments []string, output iox.Writer, errors iox.Writer) int {
+	var flags = makeFlags("generate", errors)
+	var check = flags.Bool("check", false, "report stale generated files as unified diffs instead of rewriting them")
+	var module = flags.String("module", "", "the import path of the generated module (required)")
+	var wiki = flags.String("wiki", "", "the wiki documenting the generated module (default: <module>/wiki)")
+	var directory = flags.String("directory", "", "the module directory (default: the directory of the syntax)")
+	var replacements = flags.String("templates", "", "a directory of replacement templates, e.g. scanner/classTemplate.go.tmpl")
+	if !parseFlags(flags, arguments, true) {
+		return usageError_
+	}
+	if len(*module) == 0 {
+		fmt.Fprintln(errors, "The -module flag is required.")
+		return usageError_
+	}
+	var filename = flags.Arg(0)
+	var syntax = resolveSyntax(filename, errors)
+	if syntax == nil {
+		return failure_
+	}
+	var status = validateSyntax(filename, syntax, errors)
+	if status != success_ {
+		// An invalid syntax would generate a module that does not compile.
+		return status
+	}
+	if len(*directory) == 0 {
+		*directory = fil.Dir(filename)
+	}
+	if len(*wiki) == 0 {
+		*wiki = deriveWiki(*module)
+	}
+	var templates = gra.Templates()
+	if len(*replacements) > 0 {
+		status = attempt(*replacements, errors, func() {
+			templates = gra.Templates(osx.DirFS(*replacements))
+		})
+		if status != success_ {
+			return status
+		}
+	}
+	if *check {
+		var result = attempt(filename, errors, func() {
+			var differences = gra.CheckModuleWithTemplates(*directory, *module, *wiki, syntax, templates)
+			var iterator = differences.GetIterator()
+			for iterator.HasNext() {
+				fmt.Fprint(output, iterator.GetNext().GetValue())
+				status = failure_
+			}
+		})
+		return max(status, result)
+	}
+	return attempt(filename, errors, func() {
+		var changed = gra.GenerateModuleWithTemplates(*directory, *module, *wiki, syntax, templates)
+		var iterator = changed.GetIterator()
+		for iterator.HasNext() {
+			fmt.Fprintln(output, iterator.GetNext())
+		}
+	})
+}
+
+func newSyntax(arguments []string, output iox.Writer, errors iox.Writer) int {
+	var flags = makeFlags("new", errors)
+	var copyright = flags.String("copyright", "", "the copyright notice (default: a notice for the current year)")
+	if !parseFlags(flags, arguments, true) {
+		return usageError_
+	}
+	var name = flags.Arg(0)
+	return attempt(name, errors, func() {
+		fmt.Fprint(output, gra.GenerateSyntaxNotation(name, *copyright))
+	})
+}
+
+func scanTokens(arguments []string, output iox.Writer, errors iox.Writer) int {
+	var flags = makeFlags("tokens", errors)
+	if !parseFlags(flags, arguments, true) {
+		return usageError_
+	}
+	var filename = flags.Arg(0)
+	var bytes, err = osx.ReadFile(filename)
+	if err != nil {
+		fmt.Fprintf(errors, "%v: %v\n", filename, err)
+		return failure_
+	}
+	var status = success_
+	var tokens = gra.ScanSource(string(bytes)).GetIterator()
+	for tokens.HasNext() {
+		var token = tokens.GetNext()
+		if token.GetType() == gra.ErrorToken {
+			status = failure_
+		}
+		fmt.Fprintln(output, gra.FormatToken(token))
+	}
+	return status
+}
+
+func validateSyntaxes(arguments []string, output iox.Writer, errors iox.Writer) int {
+	var flags = makeFlags("validate", errors)
+	if !parseFlags(flags, arguments, false) {
+		return usageError_
+	}
+	var status = success_
+	for _, filename := range flags.Args() {
+		var syntax = resolveSyntax(filename, errors)
+		if syntax == nil {
+			status = failure_
+			continue
+		}
+		var result = validateSyntax(filename, syntax, errors)
+		if result != success_ {
+			status = result
+		}
+	}
+	return status
+}
+
+// Private
+
+func attempt(name string, errors iox.Writer, action func()) (status int) {
+	// The framework reports any problems by panicking.
+	defer func() {
+		if e := recover(); e != nil {
+			fmt.Fprintf(errors, "%v: %v\n", name, e)
+			status = failure_
+		}
+	}()
+	action()
+	return success_
+}
+
+func deriveWiki(module string) string {
+	// A major version suffix is not part of the repository path, e.g.
+	// "github.com/acme/language/v2" -> "github.com/acme/language/wiki".
+	var repository = module
+	var index = sts.LastIndex(module, "/")
+	if index > 0 && reg.MustCompile(`^v[0-9]+$`).MatchString(module[index+1:]) {
+		repository = module[:index]
+	}
+	return repository + "/wiki"
+}
+
+func makeFlags(command string, errors iox.Writer) *flg.FlagSet {
+	var flags = flg.NewFlagSet(command, flg.ContinueOnError)
+	flags.SetOutput(errors)
+	flags.Usage = func() {
+		fmt.Fprint(errors, usage_)
+		flags.PrintDefaults()
+	}
+	return flags
+}
+
+func parseFlags(
+	flags *flg.FlagSet,
+	arguments []string,
+	isSingular bool,
+) bool {
+	if flags.Parse(arguments) != nil {
+		// The flag set has already reported the problem.
+		return false
+	}
+	var count = flags.NArg()
+	if count == 0 || (isSingular && count > 1) {
+		flags.Usage()
+		return false
+	}
+	return true
+}
+
+func parseSyntax(filename string, errors iox.Writer) (string, gra.SyntaxLike) {
+	var bytes, err = osx.ReadFile(filename)
+	if err != nil {
+		fmt.Fprintf(errors, "%v: %v\n", filename, err)
+		return "", nil
+	}
+	var source = string(bytes)
+	var syntax, problems = gra.ParseSourceWithErrors(source)
+	if !problems.IsEmpty() {
+		var iterator = problems.GetIterator()
+		for iterator.HasNext() {
+			fmt.Fprintf(errors, "%v:%v\n", filename, iterator.GetNext().Error())
+		}
+		return source, nil
+	}
+	return source, syntax
+}
+
+func resolveSyntax(filename string, errors iox.Writer) (resolved gra.SyntaxLike) {
+	var _, syntax = parseSyntax(filename, errors)
+	if syntax == nil {
+		return nil
+	}
+
+	// Any imported syntax files are found relative to the importing file.
+	var directories = col.List[string]([]string{fil.Dir(filename)})
+	attempt(filename, errors, func() {
+		resolved = gra.ResolveSyntaxFile(directories, filename, syntax)
+	})
+	return resolved
+}
+
+func validateSyntax(filename string, syntax gra.SyntaxLike, errors iox.Writer) int {
+	var warnings abs.Sequential[string]
+	var status = attempt(filename, errors, func() {
+		warnings = gra.ValidateSyntax(syntax)
+	})
+	if status != success_ {
+		return status
+	}
+	var iterator = warnings.GetIterator()
+	for iterator.HasNext() {
+		fmt.Fprintf(errors, "%v:%v\n", filename, iterator.GetNext())
+	}
+	return success_
+}
+
+// PRIVATE GLOBALS
+
+// Constants
+
+const (
+	success_    = 0
+	failure_    = 1
+	usageError_ = 2
+)
+
+const usage_ = `usage:
+  cdsn validate <syntax>...
+  cdsn format [-check] <syntax>...
+  cdsn generate [-check] -module <path> [-wiki <url>] [-directory <dir>]
+                [-templates <dir>] <syntax>
+  cdsn new [-copyright <text>] <name>
+  cdsn tokens <syntax>
+`
+
+// Variables
+
+var commands_ = map[string]func([]string, iox.Writer, iox.Writer) int{
+	"format":   formatSyntaxes,
+	"generate": generateModule,
+	"new":      newSyntax,
+	"tokens":   scanTokens,
+	"validate": validateSyntaxes,
+}
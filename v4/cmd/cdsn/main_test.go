@@ -0,0 +1,203 @@
+/*
+................................................................................
+.    Copyright (c) 2009-2024 Crater Dog Technologies.  All Rights Reserved.    .
+................................................................................
+.  DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               .
+.                                                                              .
+.  This code is free software; you can redistribute it and/or modify it under  .
+.  the terms of The MIT License (MIT), as published by the Open Source         .
+.  Initiative. (See https://opensource.org/license/MIT)                        .
+................................................................................
+*/
+
+package main
+
+import (
+	byt "bytes"
+	ass "github.com/stretchr/testify/assert"
+	osx "os"
+	fil "path/filepath"
+	sts "strings"
+	tes "testing"
+)
+
+const invalidSyntax = `!>
+INVALID
+<!
+
+!>
+RULES
+<!
+Document: Missing
+
+!>
+EXPRESSIONS
+<!
+`
+
+func execute(arguments ...string) (status int, output string, errors string) {
+	var stdout, stderr byt.Buffer
+	status = run(arguments, &stdout, &stderr)
+	return status, stdout.String(), stderr.String()
+}
+
+func TestUsage(t *tes.T) {
+	var status, _, errors = execute()
+	ass.Equal(t, usageError_, status)
+	ass.Contains(t, errors, "usage:")
+
+	status, _, errors = execute("compile", "Syntax.cdsn")
+	ass.Equal(t, usageError_, status)
+	ass.Contains(t, errors, `The command "compile" is not supported.`)
+
+	status, _, _ = execute("validate")
+	ass.Equal(t, usageError_, status)
+
+	status, _, errors = execute("generate", "../../Syntax.cdsn")
+	ass.Equal(t, usageError_, status)
+	ass.Contains(t, errors, "The -module flag is required.")
+}
+
+func TestValidate(t *tes.T) {
+	// Warnings about a valid syntax do not fail the validation.
+	var status, _, errors = execute("validate", "../../Syntax.cdsn")
+	ass.Equal(t, success_, status)
+	ass.True(t, sts.HasPrefix(errors, "../../Syntax.cdsn:"))
+	ass.Contains(t, errors, `The "uppercase" token cannot match "ANY" since the "intrinsic" token is scanned first and matches "ANY".`)
+
+	var filename = fil.Join(t.TempDir(), "Invalid.cdsn")
+	osx.WriteFile(filename, []byte(invalidSyntax), 0644)
+	status, _, errors = execute("validate", filename)
+	ass.Equal(t, failure_, status)
+	ass.Contains(t, errors, `8:11: The rule "Missing" is referenced but never defined.`)
+}
+
+const rulelessSyntax = `!>
+RULELESS
+<!
+
+!>
+RULES
+<!
+
+!>
+EXPRESSIONS
+<!
+name: LOWER+
+
+`
+
+func TestValidateRuleless(t *tes.T) {
+	// A syntax containing only expressions is validated without a panic.
+	var filename = fil.Join(t.TempDir(), "Ruleless.cdsn")
+	osx.WriteFile(filename, []byte(rulelessSyntax), 0644)
+	var status, _, errors = execute("validate", filename)
+	ass.Equal(t, success_, status)
+	ass.Equal(t, "", errors)
+}
+
+func TestNewAndFormat(t *tes.T) {
+	var status, source, _ = execute("new", "-copyright", "Copyright (c) ACME.", "example")
+	ass.Equal(t, success_, status)
+	ass.Contains(t, source, "Copyright (c) ACME.")
+
+	// A new syntax is already formatted.
+	var filename = fil.Join(t.TempDir(), "Syntax.cdsn")
+	osx.WriteFile(filename, []byte(source), 0644)
+	status, _, _ = execute("format", "--check", filename)
+	ass.Equal(t, success_, status)
+
+	// The formatter separates a note from its definition by two spaces.
+	var unformatted = sts.Replace(source, "\"'\"  !", "\"'\"      !", 1)
+	osx.WriteFile(filename, []byte(unformatted), 0644)
+	var errors string
+	status, _, errors = execute("format", "--check", filename)
+	ass.Equal(t, failure_, status)
+	ass.Contains(t, errors, "The syntax is not formatted.")
+	status, _, _ = execute("format", filename)
+	ass.Equal(t, success_, status)
+	var bytes, _ = osx.ReadFile(filename)
+	ass.Equal(t, source, string(bytes))
+}
+
+func TestTokens(t *tes.T) {
+	var status, output, _ = execute("tokens", "../../Syntax.cdsn")
+	ass.Equal(t, success_, status)
+	ass.True(t, sts.HasPrefix(output, "Token [type: comment, line: 1, position: 1]:"))
+}
+
+func TestGenerate(t *tes.T) {
+	var directory = t.TempDir()
+	var status, output, _ = execute(
+		"generate",
+		"-module", "example.com/language",
+		"-directory", directory,
+		"../../Syntax.cdsn",
+	)
+	ass.Equal(t, success_, status)
+	ass.Contains(t, output, fil.Join(directory, "grammar", "parser.go"))
+
+	// The wiki is derived from the module path by default.
+	var bytes, _ = osx.ReadFile(fil.Join(directory, "ast", "Package.go"))
+	ass.Contains(t, string(bytes), "https://example.com/language/wiki")
+	ass.NotContains(t, string(bytes), "craterdog/go-grammar-framework/wiki")
+	ass.Equal(t, "github.com/acme/language/wiki", deriveWiki("github.com/acme/language/v2"))
+
+	// Nothing is reported when the generated module is already up to date.
+	status, output, _ = execute(
+		"generate",
+		"-module", "example.com/language",
+		"-directory", directory,
+		"../../Syntax.cdsn",
+	)
+	ass.Equal(t, success_, status)
+	ass.Empty(t, output)
+
+	// A stale module is reported by the check without being rewritten.
+	var token = fil.Join(directory, "grammar", "token.go")
+	osx.Remove(token)
+	status, output, _ = execute(
+		"generate",
+		"-check",
+		"-module", "example.com/language",
+		"-directory", directory,
+		"../../Syntax.cdsn",
+	)
+	ass.Equal(t, failure_, status)
+	ass.True(t, sts.HasPrefix(output, "--- /dev/null\n+++ "+token+" (generated)\n"))
+	var _, err = osx.Stat(token)
+	ass.True(t, osx.IsNotExist(err))
+
+	// Replacement templates must contain the placeholders that are filled in.
+	var templates = t.TempDir()
+	osx.Mkdir(fil.Join(templates, "parser"), 0755)
+	var template = fil.Join(templates, "parser", "classTemplate.go.tmpl")
+	osx.WriteFile(template, []byte("package grammar\n"), 0644)
+	var errors string
+	status, _, errors = execute(
+		"generate",
+		"-module", "example.com/language",
+		"-directory", directory,
+		"-templates", templates,
+		"../../Syntax.cdsn",
+	)
+	ass.Equal(t, failure_, status)
+	ass.Contains(t, errors, `The template "parser/classTemplate" is missing`)
+}
+
+func TestGenerateInvalid(t *tes.T) {
+	// An invalid syntax is reported without generating anything.
+	var directory = t.TempDir()
+	var filename = fil.Join(directory, "Invalid.cdsn")
+	osx.WriteFile(filename, []byte(invalidSyntax), 0644)
+	var status, output, errors = execute(
+		"generate",
+		"-module", "example.com/language",
+		filename,
+	)
+	ass.Equal(t, failure_, status)
+	ass.Empty(t, output)
+	ass.Contains(t, errors, `8:11: The rule "Missing" is referenced but never defined.`)
+	var _, err = osx.Stat(fil.Join(directory, "ast"))
+	ass.True(t, osx.IsNotExist(err))
+}
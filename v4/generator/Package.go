@@ -34,6 +34,7 @@ import (
 	abs "github.com/craterdog/go-collection-framework/v4/collection"
 	ast "github.com/craterdog/go-grammar-framework/v4/ast"
 	gra "github.com/craterdog/go-grammar-framework/v4/grammar"
+	fsx "io/fs"
 )
 
 // Classes
@@ -54,6 +55,7 @@ functions that must be supported by all formatter-class-like classes.
 type FormatterClassLike interface {
 	// Constructor
 	Make() FormatterLike
+	MakeWithTemplates(templates TemplatesLike) FormatterLike
 }
 
 /*
@@ -63,6 +65,7 @@ functions that must be supported by all grammar-class-like classes.
 type GrammarClassLike interface {
 	// Constructor
 	Make() GrammarLike
+	MakeWithTemplates(templates TemplatesLike) GrammarLike
 }
 
 /*
@@ -72,6 +75,46 @@ functions that must be supported by all ast-class-like classes.
 type AstClassLike interface {
 	// Constructor
 	Make() AstLike
+	MakeWithTemplates(templates TemplatesLike) AstLike
+}
+
+/*
+MergerClassLike defines the set of class constants, constructors and
+functions that must be supported by all merger-class-like classes.
+*/
+type MergerClassLike interface {
+	// Constructor
+	Make() MergerLike
+}
+
+/*
+ModuleClassLike defines the set of class constants, constructors and
+functions that must be supported by all module-class-like classes.
+*/
+type ModuleClassLike interface {
+	// Constructor
+	Make() ModuleLike
+	MakeWithTemplates(templates TemplatesLike) ModuleLike
+}
+
+/*
+NodeClassLike defines the set of class constants, constructors and
+functions that must be supported by all node-class-like classes.
+*/
+type NodeClassLike interface {
+	// Constructor
+	Make() NodeLike
+	MakeWithTemplates(templates TemplatesLike) NodeLike
+}
+
+/*
+ParseErrorClassLike defines the set of class constants, constructors and
+functions that must be supported by all parse-error-class-like classes.
+*/
+type ParseErrorClassLike interface {
+	// Constructor
+	Make() ParseErrorLike
+	MakeWithTemplates(templates TemplatesLike) ParseErrorLike
 }
 
 /*
@@ -81,6 +124,7 @@ functions that must be supported by all parser-class-like classes.
 type ParserClassLike interface {
 	// Constructor
 	Make() ParserLike
+	MakeWithTemplates(templates TemplatesLike) ParserLike
 }
 
 /*
@@ -90,6 +134,16 @@ functions that must be supported by all processor-class-like classes.
 type ProcessorClassLike interface {
 	// Constructor
 	Make() ProcessorLike
+	MakeWithTemplates(templates TemplatesLike) ProcessorLike
+}
+
+/*
+ResolverClassLike defines the set of class constants, constructors and
+functions that must be supported by all resolver-class-like classes.
+*/
+type ResolverClassLike interface {
+	// Constructor
+	Make(directories abs.Sequential[string]) ResolverLike
 }
 
 /*
@@ -99,6 +153,7 @@ functions that must be supported by all scanner-class-like classes.
 type ScannerClassLike interface {
 	// Constructor
 	Make() ScannerLike
+	MakeWithTemplates(templates TemplatesLike) ScannerLike
 }
 
 /*
@@ -108,6 +163,16 @@ functions that must be supported by all syntax-class-like classes.
 type SyntaxClassLike interface {
 	// Constructor
 	Make() SyntaxLike
+	MakeWithTemplates(templates TemplatesLike) SyntaxLike
+}
+
+/*
+TemplatesClassLike defines the set of class constants, constructors and
+functions that must be supported by all templates-class-like classes.
+*/
+type TemplatesClassLike interface {
+	// Constructor
+	Make() TemplatesLike
 }
 
 /*
@@ -117,6 +182,7 @@ functions that must be supported by all token-class-like classes.
 type TokenClassLike interface {
 	// Constructor
 	Make() TokenLike
+	MakeWithTemplates(templates TemplatesLike) TokenLike
 }
 
 /*
@@ -126,6 +192,7 @@ functions that must be supported by all validator-class-like classes.
 type ValidatorClassLike interface {
 	// Constructor
 	Make() ValidatorLike
+	MakeWithTemplates(templates TemplatesLike) ValidatorLike
 }
 
 /*
@@ -135,6 +202,7 @@ functions that must be supported by all visitor-class-like classes.
 type VisitorClassLike interface {
 	// Constructor
 	Make() VisitorLike
+	MakeWithTemplates(templates TemplatesLike) VisitorLike
 }
 
 // Instances
@@ -147,16 +215,28 @@ type AnalyzerLike interface {
 	// Public
 	GetClass() AnalyzerClassLike
 	AnalyzeSyntax(syntax ast.SyntaxLike)
+	GetCaselessDelimiters() abs.Sequential[string]
 	GetExpressions() abs.Sequential[abs.AssociationLike[string, string]]
+	GetFirst(ruleName string) abs.Sequential[string]
+	GetFollow(ruleName string) abs.Sequential[string]
 	GetIdentifiers(ruleName string) abs.Sequential[ast.IdentifierLike]
+	GetMode(tokenName string) string
+	GetModes() abs.Sequential[string]
 	GetNotice() string
+	GetPrecedence(ruleName string) ast.PrecedenceLike
+	GetPrecedenceRule(className string) string
 	GetReferences(ruleName string) abs.Sequential[ast.ReferenceLike]
 	GetRuleNames() abs.Sequential[string]
 	GetSyntaxMap() string
 	GetSyntaxName() string
 	GetTerms(ruleName string) abs.Sequential[ast.TermLike]
 	GetTokenNames() abs.Sequential[string]
+	GetTransition(tokenName string) ast.TransitionLike
+	GetWarnings() abs.Sequential[string]
+	HasKeywords() bool
 	IsDelimited(ruleName string) bool
+	IsIndented() bool
+	IsNullable(name string) bool
 	IsPlural(name string) bool
 
 	// Aspect
@@ -209,6 +289,88 @@ type AstLike interface {
 	)
 }
 
+/*
+MergerLike defines the set of aspects and methods that must be supported by
+all merger-like instances.  A merger refreshes an existing source file with
+newly generated source code while preserving each top-level declaration in the
+existing file whose doc comment contains the "//cdsn:preserve" directive.  A
+preserved declaration replaces the generated declaration with the same name, or
+if there is none, follows the declaration that preceded it in the existing file.
+Any imports used by the preserved declarations are retained.
+*/
+type MergerLike interface {
+	// Public
+	GetClass() MergerClassLike
+	MergeSource(
+		existing string,
+		generated string,
+	) (
+		merged string,
+	)
+}
+
+/*
+ModuleLike defines the set of aspects and methods that must be supported by
+all module-like instances.  A module generates the complete "ast" and "grammar"
+packages for a syntax within a directory, formatting each file and reporting
+the paths of the files whose contents changed.  Any hand-written declarations
+marked as preserved in an existing file are merged into the regenerated file.
+It can also check an existing
+module for drift, returning a unified diff for each file that does not match
+what would be generated.
+*/
+type ModuleLike interface {
+	// Public
+	GetClass() ModuleClassLike
+	CheckModule(
+		directory string,
+		module string,
+		wiki string,
+		syntax ast.SyntaxLike,
+	) (
+		differences abs.CatalogLike[string, string],
+	)
+	GenerateModule(
+		directory string,
+		module string,
+		wiki string,
+		syntax ast.SyntaxLike,
+	) (
+		changed abs.Sequential[string],
+	)
+}
+
+/*
+NodeLike defines the set of aspects and methods that must be supported by all
+node-like instances.  A node generates the implementation of the AST class
+with the specified name.
+*/
+type NodeLike interface {
+	// Public
+	GetClass() NodeClassLike
+	GenerateNodeClass(
+		syntax ast.SyntaxLike,
+		className string,
+	) (
+		implementation string,
+	)
+}
+
+/*
+ParseErrorLike defines the set of aspects and methods that must be supported by
+all parse-error-like instances.
+*/
+type ParseErrorLike interface {
+	// Public
+	GetClass() ParseErrorClassLike
+	GenerateParseErrorClass(
+		module string,
+		syntax ast.SyntaxLike,
+	) (
+		implementation string,
+	)
+}
+
 /*
 ParserLike defines the set of aspects and methods that must be supported by
 all parser-like instances.
@@ -239,6 +401,25 @@ type ProcessorLike interface {
 	)
 }
 
+/*
+ResolverLike defines the set of aspects and methods that must be supported by
+all resolver-like instances.  A resolver merges the rules and expressions of
+each syntax file imported by a syntax—found using the directories in its search
+path—into a single syntax, reporting any names that clash.  When the path of
+the importing syntax file is known, an import cycle back to it is reported as
+well.
+*/
+type ResolverLike interface {
+	// Public
+	GetClass() ResolverClassLike
+	GetDirectories() abs.Sequential[string]
+	ResolveSyntax(syntax ast.SyntaxLike) ast.SyntaxLike
+	ResolveSyntaxFile(
+		path string,
+		syntax ast.SyntaxLike,
+	) ast.SyntaxLike
+}
+
 /*
 ScannerLike defines the set of aspects and methods that must be supported by
 all scanner-like instances.
@@ -269,6 +450,31 @@ type SyntaxLike interface {
 	)
 }
 
+/*
+TemplatesLike defines the set of aspects and methods that must be supported by
+all templates-like instances.  A template set contains every template used by
+the generators, each named for its generator and template, e.g.
+"scanner/classTemplate", and initially holding the default template.  A
+replacement template may be supplied for any name, either directly or from a
+file system containing a file for each replacement, e.g.
+"scanner/classTemplate.go.tmpl".  Each replacement must contain, in any case
+form, every placeholder in the default template that the generator fills in,
+e.g. "<SyntaxName>" or "<Methods>", other than the documentation placeholders
+"<Notice>", "<Copyright>" and "<wiki>".
+*/
+type TemplatesLike interface {
+	// Public
+	GetClass() TemplatesClassLike
+	GetNames() abs.Sequential[string]
+	GetPlaceholders(name string) abs.Sequential[string]
+	GetTemplate(name string) string
+	SetTemplate(
+		name string,
+		template string,
+	)
+	LoadTemplates(directory fsx.FS)
+}
+
 /*
 TokenLike defines the set of aspects and methods that must be supported by
 all token-like instances.
@@ -13,14 +13,1359 @@
 package generator_test
 
 import (
+	col "github.com/craterdog/go-collection-framework/v4"
 	gen "github.com/craterdog/go-grammar-framework/v4/generator"
 	gra "github.com/craterdog/go-grammar-framework/v4/grammar"
 	//mod "github.com/craterdog/go-model-framework/v4"
 	ass "github.com/stretchr/testify/assert"
 	osx "os"
+	fil "path/filepath"
+	sts "strings"
 	tes "testing"
+	fst "testing/fstest"
+)
+
+const nullableSyntax = `!>
+NOTICE
+<!
+
+!>
+RULES
+<!
+Syntax: List Tokens
+
+List: Item+
+
+Item: Empty Empty
+
+Empty: name?
+
+Tokens: blank* name word
+
+!>
+EXPRESSIONS
+<!
+blank: " "?
+
+name: LOWER+ ("-"?)*
+
+word: LOWER blank+
+
+`
+
+func TestNullability(t *tes.T) {
+	var parser = gra.Parser().Make()
+	var syntax = parser.ParseSource(nullableSyntax)
+	var analyzer = gen.Analyzer().Make()
+	analyzer.AnalyzeSyntax(syntax)
+	ass.False(t, analyzer.IsNullable("Syntax"))
+	ass.True(t, analyzer.IsNullable("List"))
+	ass.True(t, analyzer.IsNullable("Item"))
+	ass.True(t, analyzer.IsNullable("Empty"))
+	ass.False(t, analyzer.IsNullable("Tokens"))
+	ass.True(t, analyzer.IsNullable("blank"))
+	ass.False(t, analyzer.IsNullable("name"))
+	ass.False(t, analyzer.IsNullable("word"))
+	ass.False(t, analyzer.IsNullable("Missing"))
+}
+
+const separatedSyntax = `!>
+NOTICE
+<!
+
+!>
+RULES
+<!
+Syntax: List Pair
+
+List: Item* / ","
+
+Pair: Item{2} / ","
+
+Item: name?
+
+!>
+EXPRESSIONS
+<!
+name: LOWER+
+
+`
+
+func TestSeparatedNullability(t *tes.T) {
+	// A separator consumes a token whenever at least two instances are required.
+	var parser = gra.Parser().Make()
+	var syntax = parser.ParseSource(separatedSyntax)
+	var analyzer = gen.Analyzer().Make()
+	analyzer.AnalyzeSyntax(syntax)
+	ass.True(t, analyzer.IsNullable("Item"))
+	ass.True(t, analyzer.IsNullable("List"))
+	ass.False(t, analyzer.IsNullable("Pair"))
+	ass.Equal(t, []string{`","`, "name"}, analyzer.GetFirst("Pair").AsArray())
+}
+
+const rulelessSyntax = `!>
+NOTICE
+<!
+
+!>
+RULES
+<!
+
+!>
+EXPRESSIONS
+<!
+name: LOWER+
+
+`
+
+func TestRulelessAnalysis(t *tes.T) {
+	// A syntax whose rules are all imported can still be analyzed.
+	var parser = gra.Parser().Make()
+	var syntax = parser.ParseSource(rulelessSyntax)
+	var analyzer = gen.Analyzer().Make()
+	analyzer.AnalyzeSyntax(syntax)
+	ass.Equal(t, "", analyzer.GetSyntaxName())
+	ass.Equal(t, 0, analyzer.GetRuleNames().GetSize())
+	ass.True(t, analyzer.GetWarnings().IsEmpty())
+}
+
+const shadowedSyntax = `!>
+NOTICE
+<!
+
+!>
+RULES
+<!
+Syntax: Statement+
+
+Statement:
+  - Assignment
+  - Call
+  - Invocation
+  - Empty
+  - Expression
+
+Assignment: name "=" number
+
+Call: name "(" ")"
+
+Invocation: name "." name
+
+Empty: ";"
+
+Expression:
+  - Call
+  - Literal
+
+Literal: number
+
+!>
+EXPRESSIONS
+<!
+name: LOWER+
+
+number: DIGIT+
+
+`
+
+func TestShadowing(t *tes.T) {
+	var parser = gra.Parser().Make()
+	var syntax = parser.ParseSource(shadowedSyntax)
+	var analyzer = gen.Analyzer().Make()
+	analyzer.AnalyzeSyntax(syntax)
+	ass.Equal(t, []string{`";"`, "name", "number"}, analyzer.GetFirst("Statement").AsArray())
+	ass.Equal(
+		t,
+		[]string{
+			`";"`,
+			"<EOF>",
+			"name",
+			"number",
+		},
+		analyzer.GetFollow("Call").AsArray(),
+	)
+	ass.Equal(
+		t,
+		[]string{
+			`12:5: The "Call" alternative in the "Statement" rule can never be selected since earlier alternatives match: name`,
+			`13:5: The "Invocation" alternative in the "Statement" rule can never be selected since earlier alternatives match: name`,
+			`15:5: The "Expression" alternative in the "Statement" rule requires backtracking since earlier alternatives also match: name`,
+		},
+		analyzer.GetWarnings().AsArray(),
+	)
+}
+
+const shadowedTokens = `!>
+NOTICE
+<!
+
+!>
+RULES
+<!
+Syntax: Value+
+
+Value:
+  - number
+  - version
+
+!>
+EXPRESSIONS
+<!
+number: DIGIT+
+
+version: DIGIT+ "." DIGIT+
+
+`
+
+func TestTokenShadowing(t *tes.T) {
+	var parser = gra.Parser().Make()
+	var syntax = parser.ParseSource(shadowedTokens)
+	var analyzer = gen.Analyzer().Make()
+	analyzer.AnalyzeSyntax(syntax)
+	ass.Equal(
+		t,
+		[]string{
+			`19:1: The "version" token cannot match "0.0" since the "number" token is scanned first and matches "0".`,
+		},
+		analyzer.GetWarnings().AsArray(),
+	)
+
+	// The syntax for this framework only shadows uppercase names by intrinsics.
+	var bytes, err = osx.ReadFile("../Syntax.cdsn")
+	if err != nil {
+		panic(err)
+	}
+	syntax = parser.ParseSource(string(bytes))
+	analyzer.AnalyzeSyntax(syntax)
+	var warnings = analyzer.GetWarnings()
+	ass.Equal(t, 1, warnings.GetSize())
+	ass.Contains(
+		t,
+		warnings.AsArray()[0],
+		`The "uppercase" token cannot match "ANY" since the "intrinsic" token is scanned first and matches "ANY".`,
+	)
+}
+
+const shadowedLiterals = `!>
+NOTICE
+<!
+
+!>
+RULES
+<!
+Syntax: Loop+
+
+Loop: "do" label? initial "end"i
+
+!>
+EXPRESSIONS
+<!
+initial: UPPER
+
+label: "do:"
+
+`
+
+func TestLiteralShadowing(t *tes.T) {
+	// A literal is split by a token that matches its start, and a keyword that
+	// starts the literal of another token shadows that token.
+	var parser = gra.Parser().Make()
+	var syntax = parser.ParseSource(shadowedLiterals)
+	var analyzer = gen.Analyzer().Make()
+	analyzer.AnalyzeSyntax(syntax)
+	ass.Equal(
+		t,
+		[]string{
+			`The "keyword" token cannot match "END" since the "initial" token is scanned first and matches "E".`,
+			`17:1: The "label" token cannot match "do:" since the "keyword" token is scanned first and matches "do".`,
+		},
+		analyzer.GetWarnings().AsArray(),
+	)
+}
+
+const lookaheadSyntax = `!>
+NOTICE
+<!
+
+!>
+RULES
+<!
+Syntax: Block
+
+Block: "{" Statement* "}"
+
+Statement: !"}" &name Call
+
+Call: name "(" ")"
+
+!>
+EXPRESSIONS
+<!
+name: LOWER+
+
+`
+
+func TestLookahead(t *tes.T) {
+	var parser = gra.Parser().Make()
+	var syntax = parser.ParseSource(lookaheadSyntax)
+	var analyzer = gen.Analyzer().Make()
+	analyzer.AnalyzeSyntax(syntax)
+
+	// Only the consumed call becomes an attribute of the statement.
+	ass.Equal(t, 3, analyzer.GetTerms("Statement").GetSize())
+	ass.Equal(t, 1, analyzer.GetReferences("Statement").GetSize())
+	ass.Equal(t, []string{"name"}, analyzer.GetFirst("Statement").AsArray())
+
+	// The lookaheads are compiled into calls that put back the parsed tokens.
+	var implementation = gen.Parser().Make().GenerateParserClass("example", syntax)
+	ass.Contains(t, implementation, `
+	// Look ahead to make sure the next term is not found.
+	token, ok = v.lookAhead(func() (token TokenLike, ok bool) {
+		_, token, ok = v.parseDelimiter("}")
+		return token, ok
+	})
+	if ok {`)
+	ass.Contains(t, implementation, `
+	// Look ahead for the next term without consuming it.
+	token, ok = v.lookAhead(func() (token TokenLike, ok bool) {
+		_, token, ok = v.parseToken(NameToken)
+		return token, ok
+	})
+	if !ok {`)
+
+	// A failed lookahead backtracks over any terms that preceded it in the rule.
+	ass.Contains(t, implementation, `
+	if !ok {
+		// This is not a single statement rule so put back any tokens parsed for it.
+		v.putBackTokens(start_)
+		return statement, token, false
+	}`)
+	ass.Contains(t, implementation, `
+	statement = ast.Statement().MakeWithSpan(
+		v.getSpan(start_),
+		call,
+	)`)
+}
+
+const recoverySyntax = `!>
+NOTICE
+<!
+
+!>
+RULES
+<!
+Syntax: Statement+ "end"
+
+Statement: name "=" number ";"
+
+!>
+EXPRESSIONS
+<!
+name: LOWER+
+
+number: DIGIT+
+
+`
+
+func TestRecovery(t *tes.T) {
+	var parser = gra.Parser().Make()
+	var syntax = parser.ParseSource(recoverySyntax)
+	var implementation = gen.Parser().Make().GenerateParserClass("example", syntax)
+
+	// The repeated statements recover from syntax errors.
+	ass.Contains(t, implementation, `
+		if v.attemptRecovery("Statement", func() { statement, token, ok = v.parseStatement() }) {`)
+
+	// Parsing resumes with another statement or whatever follows them.
+	ass.Contains(t, implementation, `
+		"Statement": {"name"},
+	},`)
+	ass.Contains(t, implementation, `
+		"Statement": {`+"`\"end\"`"+`, "name"},
+	},`)
+}
+
+const precedenceSyntax = `!>
+NOTICE
+<!
+
+!>
+RULES
+<!
+Syntax: Sum
+
+Sum: % Factor
+  = "=="
+  < "+" "-"
+  > "^"
+
+Factor:
+  - Group
+  - number
+
+Group: "(" Sum ")"
+
+!>
+EXPRESSIONS
+<!
+number: DIGIT+
+
+`
+
+func TestPrecedence(t *tes.T) {
+	var parser = gra.Parser().Make()
+	var syntax = parser.ParseSource(precedenceSyntax)
+	var analyzer = gen.Analyzer().Make()
+	analyzer.AnalyzeSyntax(syntax)
+
+	// The binary operations of the table are represented by a single class.
+	ass.Equal(t, "Sum", analyzer.GetPrecedenceRule("SumOperation"))
+	ass.Equal(t, "", analyzer.GetPrecedenceRule("Sum"))
+	ass.Contains(t, analyzer.GetRuleNames().AsArray(), "SumOperation")
+	ass.Equal(t, []string{`"("`, "number"}, analyzer.GetFirst("Sum").AsArray())
+	ass.Equal(
+		t,
+		[]string{`")"`, `"+"`, `"-"`, `"=="`, `"^"`, "<EOF>"},
+		analyzer.GetFollow("Factor").AsArray(),
+	)
+	ass.Equal(t, 0, analyzer.GetWarnings().GetSize())
+
+	// The operation class holds both operands and the operator.
+	var model = gen.Ast().Make().GenerateAstModel("example", syntax)
+	ass.Contains(t, model, `
+type SumOperationClassLike interface {
+	// Constructors
+	Make(
+		left SumLike,
+		operator string,
+		right SumLike,
+	) SumOperationLike
+	MakeWithSpan(
+		span SpanLike,
+		left SumLike,
+		operator string,
+		right SumLike,
+	) SumOperationLike
+}`)
+
+	// The parser climbs the levels of precedence starting with the lowest.
+	var implementation = gen.Parser().Make().GenerateParserClass("example", syntax)
+	ass.Contains(t, implementation, `
+		switch v.foldDelimiter(operator) {
+		case "==":
+			level, associativity = 1, "="
+		case "+", "-":
+			level, associativity = 2, "<"
+		case "^":
+			level, associativity = 3, ">"
+		}`)
+	ass.Contains(t, implementation, `
+		var operation = ast.SumOperation().MakeWithSpan(span, sum, operator, right)`)
+}
+
+const separatorSyntax = `!>
+NOTICE
+<!
+
+!>
+RULES
+<!
+Syntax: Array
+
+Array: "[" Value* / ","? "]"
+
+Value: number
+
+!>
+EXPRESSIONS
+<!
+number: DIGIT+
+
+`
+
+func TestSeparators(t *tes.T) {
+	var parser = gra.Parser().Make()
+	var syntax = parser.ParseSource(separatorSyntax)
+	var analyzer = gen.Analyzer().Make()
+	analyzer.AnalyzeSyntax(syntax)
+
+	// A separated value is followed by its separator rather than itself.
+	ass.Equal(t, []string{`","`, `"]"`}, analyzer.GetFollow("Value").AsArray())
+	ass.Equal(t, 0, analyzer.GetWarnings().GetSize())
+
+	// The parser expects a separator between each pair of values.
+	var implementation = gen.Parser().Make().GenerateParserClass("example", syntax)
+	ass.Contains(t, implementation, `
+		if numberFound_ > 0 {
+			// Attempt to parse a single "," separator.
+			_, token, ok = v.parseDelimiter(",")
+			if !ok {`)
+	ass.NotContains(t, implementation, "A separator must be followed by another value rule.")
+}
+
+const labelSyntax = `!>
+NOTICE
+<!
+
+!>
+RULES
+<!
+Syntax: Document
+
+Document: header:comment Entry* footer:comment? last:Entry
+
+Entry: name
+
+!>
+EXPRESSIONS
+<!
+comment: "!" ~[EOL]*
+
+name: LOWER+
+
+`
+
+func TestLabels(t *tes.T) {
+	var parser = gra.Parser().Make()
+	var syntax = parser.ParseSource(labelSyntax)
+	var analyzer = gen.Analyzer().Make()
+	analyzer.AnalyzeSyntax(syntax)
+
+	// A label is not the name of a token.
+	ass.NotContains(t, analyzer.GetTokenNames().AsArray(), "header")
+
+	// The labels name the attributes instead of the identifiers.
+	var model = gen.Ast().Make().GenerateAstModel("example", syntax)
+	ass.Contains(t, model, `
+	Make(
+		header string,
+		entrys abs.Sequential[EntryLike],
+		optionalFooter string,
+		last EntryLike,
+	) DocumentLike`)
+	ass.Contains(t, model, `
+	GetHeader() string`)
+	ass.NotContains(t, model, "GetComment1")
+
+	// The parser and visitor use the same names.
+	var implementation = gen.Parser().Make().GenerateParserClass("example", syntax)
+	ass.Contains(t, implementation, `
+	var optionalFooter string
+	optionalFooter, _, ok = v.parseToken(CommentToken)`)
+	implementation = gen.Visitor().Make().GenerateVisitorClass("example", syntax)
+	ass.Contains(t, implementation, `
+	var header = document.GetHeader()
+	v.processor_.ProcessComment(header)`)
+}
+
+const caselessSyntax = `!>
+NOTICE
+<!
+
+!>
+RULES
+<!
+Syntax: "select"i name "Limit" hex
+
+!>
+EXPRESSIONS
+<!
+hex: (?i) "0x" ['0'..'9' 'a'..'f']+
+
+name: LOWER+
+
+`
+
+func TestCaseless(t *tes.T) {
+	var parser = gra.Parser().Make()
+	var syntax = parser.ParseSource(caselessSyntax)
+	var analyzer = gen.Analyzer().Make()
+	analyzer.AnalyzeSyntax(syntax)
+
+	// The scanner matches the marked literals and patterns regardless of case.
+	var expressions = col.Catalog[string, string](analyzer.GetExpressions())
+	ass.Equal(t, `"(?:(?i:select)|Limit)"`, expressions.GetValue("keyword"))
+	ass.Equal(t, `"(?i:0x[0-9a-f]+)"`, expressions.GetValue("hex"))
+	ass.Equal(t, []string{"select"}, analyzer.GetCaselessDelimiters().AsArray())
+
+	// The parser folds the case of the caseless delimiters only.
+	var implementation = gen.Parser().Make().GenerateParserClass("example", syntax)
+	ass.Contains(t, implementation, `
+var caselessDelimiters_ = col.Set[string]([]string{"select"})`)
+	ass.Contains(t, implementation, `v.parseKeyword("select")`)
+	ass.Contains(t, implementation, `v.parseKeyword("Limit")`)
+}
+
+const keywordSyntax = `!>
+NOTICE
+<!
+
+!>
+RULES
+<!
+Statement: "if" name "=" name
+
+!>
+EXPRESSIONS
+<!
+name: LOWER (LOWER | DIGIT)*
+
+`
+
+func TestKeywords(t *tes.T) {
+	var parser = gra.Parser().Make()
+	var syntax = parser.ParseSource(keywordSyntax)
+	var analyzer = gen.Analyzer().Make()
+	analyzer.AnalyzeSyntax(syntax)
+
+	// Word literals are scanned as keywords rather than delimiters.
+	var expressions = col.Catalog[string, string](analyzer.GetExpressions())
+	ass.Equal(t, `"(?:=)"`, expressions.GetValue("delimiter"))
+	ass.Equal(t, `"(?:if)"`, expressions.GetValue("keyword"))
+	ass.True(t, analyzer.GetWarnings().IsEmpty())
+
+	// Any other token that matches a keyword exactly is scanned as that keyword.
+	var implementation = gen.Scanner().Make().GenerateScannerClass("example", syntax)
+	ass.Contains(t, implementation, `KeywordToken: reg.MustCompile("^" + keyword_),`)
+	ass.Contains(t, implementation, `
+	if tokenType != KeywordToken && keyword.FindString(match) == match {
+		tokenType = KeywordToken
+	}`)
+
+	// The parser matches keywords by their token type.
+	implementation = gen.Parser().Make().GenerateParserClass("example", syntax)
+	ass.Contains(t, implementation, `v.parseKeyword("if")`)
+	ass.Contains(t, implementation, `v.parseDelimiter("=")`)
+
+	// A syntax without any keywords does not scan for them.
+	syntax = parser.ParseSource(appendedSyntax)
+	analyzer.AnalyzeSyntax(syntax)
+	ass.False(t, analyzer.HasKeywords())
+	implementation = gen.Scanner().Make().GenerateScannerClass("example", syntax)
+	ass.NotContains(t, implementation, "keyword_")
+	ass.NotContains(t, implementation, "KeywordToken: reg.MustCompile(")
+	ass.NotContains(t, implementation, "case v.foundToken(KeywordToken):")
+	ass.NotContains(t, implementation, "Reserved keywords")
+}
+
+const intrinsicSyntax = `!>
+NOTICE
+<!
+
+!>
+RULES
+<!
+Syntax: word symbol
+
+!>
+EXPRESSIONS
+<!
+symbol: [PUNCT SYMBOL]+ ~[SPACE]?
+
+word: (LETTER | UNICODE(Greek)) SPACE*
+
+`
+
+func TestIntrinsics(t *tes.T) {
+	var parser = gra.Parser().Make()
+	var syntax = parser.ParseSource(intrinsicSyntax)
+	var analyzer = gen.Analyzer().Make()
+	analyzer.AnalyzeSyntax(syntax)
+
+	// The whitespace intrinsic is only a character class within a filter.
+	var expressions = col.Catalog[string, string](analyzer.GetExpressions())
+	ass.Equal(
+		t,
+		`"(?:[" + punct_ + "" + symbol_ + "]+[^" + white_ + "]?)"`,
+		expressions.GetValue("symbol"),
+	)
+	ass.Equal(
+		t,
+		`"(?:(" + letter_ + "|\\p{Greek})[" + white_ + "]*)"`,
+		expressions.GetValue("word"),
+	)
+
+	// The generated scanner defines each of the intrinsic patterns.
+	var implementation = gen.Scanner().Make().GenerateScannerClass("example", syntax)
+	ass.Contains(t, implementation, `letter_  = "\\p{L}"`)
+	ass.Contains(t, implementation, `white_   = "\\t-\\r\\x85\\p{Z}"`)
+}
+
+const indentedSyntax = `!>
+NOTICE
+<!
+
+$indentation
+
+!>
+RULES
+<!
+Document: Statement+
+
+Statement: name Body
+
+Body:
+  - Block
+  - Simple
+
+Block: ":" newline indent Statement+ dedent
+
+Simple: newline
+
+!>
+EXPRESSIONS
+<!
+name: LOWER+
+
+newline: EOL
+
+`
+
+func TestIndentation(t *tes.T) {
+	var parser = gra.Parser().Make()
+	var syntax = parser.ParseSource(indentedSyntax)
+	gra.Validator().Make().ValidateSyntax(syntax)
+	var analyzer = gen.Analyzer().Make()
+	analyzer.AnalyzeSyntax(syntax)
+	ass.True(t, analyzer.IsIndented())
+	ass.Equal(
+		t,
+		[]string{"dedent", "delimiter", "indent", "keyword", "name", "newline", "space"},
+		analyzer.GetTokenNames().AsArray(),
+	)
+
+	// The scanner checks the indentation before matching any tokens.
+	var implementation = gen.Scanner().Make().GenerateScannerClass("example", syntax)
+	ass.Contains(t, implementation, `
+		// Find the next token type.
+		case v.foundIndentation():
+		case v.foundToken(DelimiterToken):`)
+	ass.Contains(t, implementation, "func (v *scanner_) foundIndentation() bool {")
+	ass.NotContains(t, implementation, "case v.foundToken(IndentToken):")
+
+	// The parser reports an inconsistent indentation.
+	implementation = gen.Parser().Make().GenerateParserClass("example", syntax)
+	ass.Contains(t, implementation, `message = "The indentation does not match any enclosing indentation."`)
+}
+
+const modeSyntax = `!>
+TEMPLATE
+<!
+
+!>
+RULES
+<!
+Document: Chunk+
+
+Chunk:
+  - Block
+  - text
+
+Block: open Value* close
+
+Value:
+  - String
+  - name
+
+String: begin chars? end
+
+!>
+EXPRESSIONS
+<!
+open: '{' -> code
+
+text: ~['{']+
+
+[code]
+begin: '"' -> string
+
+close: '}' ->
+
+name: LOWER+
+
+[string]
+chars: ~['"']+
+
+end: '"' ->
+
+`
+
+func TestModes(t *tes.T) {
+	var parser = gra.Parser().Make()
+	var syntax = parser.ParseSource(modeSyntax)
+	gra.Validator().Make().ValidateSyntax(syntax)
+	var analyzer = gen.Analyzer().Make()
+	analyzer.AnalyzeSyntax(syntax)
+	ass.Equal(t, []string{"code", "string"}, analyzer.GetModes().AsArray())
+	ass.Equal(t, "", analyzer.GetMode("text"))
+	ass.Equal(t, "string", analyzer.GetMode("chars"))
+	ass.Equal(t, "code", analyzer.GetTransition("open").GetOptionalMode())
+	ass.Equal(t, "", analyzer.GetTransition("end").GetOptionalMode())
+
+	// Only the tokens of the default mode are scanned by the main loop.
+	var implementation = gen.Scanner().Make().GenerateScannerClass("example", syntax)
+	ass.Contains(t, implementation, `
+		// Find the next token type.
+		case v.foundMode():
+		case v.foundToken(DelimiterToken):`)
+	ass.NotContains(t, implementation, `
+		case v.foundMode():
+		case v.foundToken(BeginToken):`)
+
+	// Each named mode tries its own tokens before the common tokens.
+	ass.Contains(t, implementation, `
+	case "string":
+		switch {
+		case v.foundToken(CharsToken):
+		case v.foundToken(EndToken):
+		case v.foundToken(DelimiterToken):`)
+
+	// The transitions push and pop the named modes.
+	ass.Contains(t, implementation, `
+	case BeginToken:
+		v.modes_ = append(v.modes_, "string")`)
+	ass.Contains(t, implementation, `
+	case EndToken:
+		if len(v.modes_) > 0 {
+			v.modes_ = v.modes_[:len(v.modes_)-1]
+		}`)
+}
+
+const commonSyntax = `!>
+COMMON
+<!
+
+@"Numbers.cdsn"
+
+!>
+RULES
+<!
+Pair: name number
+
+!>
+EXPRESSIONS
+<!
+name: LOWER+
+
+`
+
+const numbersSyntax = `!>
+NUMBERS
+<!
+
+!>
+RULES
+<!
+!>
+EXPRESSIONS
+<!
+number: DIGIT+
+
+`
+
+const loopSyntax = `!>
+LOOP
+<!
+
+@"Loop.cdsn"
+
+!>
+RULES
+<!
+!>
+EXPRESSIONS
+<!
+`
+
+const importingSyntax = `!>
+NOTICE
+<!
+
+@"Common.cdsn"
+@"Numbers.cdsn"
+
+!>
+RULES
+<!
+Syntax: Pair+
+
+!>
+EXPRESSIONS
+<!
+`
+
+const clashingSyntax = `!>
+NOTICE
+<!
+
+@"Common.cdsn"
+@"Missing.cdsn"
+@"Loop.cdsn"
+
+!>
+RULES
+<!
+Syntax: Pair+
+
+!>
+EXPRESSIONS
+<!
+name: UPPER+
+
+`
+
+func TestResolver(t *tes.T) {
+	// Spread the imported syntax files across two directories.
+	var first = t.TempDir()
+	var second = t.TempDir()
+	var files = map[string]string{
+		fil.Join(first, "Common.cdsn"):   commonSyntax,
+		fil.Join(second, "Numbers.cdsn"): numbersSyntax,
+		fil.Join(second, "Loop.cdsn"):    loopSyntax,
+	}
+	for path, source := range files {
+		var err = osx.WriteFile(path, []byte(source), 0644)
+		if err != nil {
+			panic(err)
+		}
+	}
+	var directories = col.List[string]([]string{first, second})
+	var resolver = gen.Resolver().Make(directories)
+	var parser = gra.Parser().Make()
+
+	// Each imported file is merged in once even when imported more than once.
+	var syntax = resolver.ResolveSyntax(parser.ParseSource(importingSyntax))
+	ass.Equal(t, 0, syntax.GetImports().GetSize())
+	var rules = syntax.GetRules().GetIterator()
+	ass.Equal(t, "Syntax", rules.GetNext().GetUppercase())
+	ass.Equal(t, "Pair", rules.GetNext().GetUppercase())
+	ass.False(t, rules.HasNext())
+	var expressions = syntax.GetExpressions().GetIterator()
+	ass.Equal(t, "name", expressions.GetNext().GetLowercase())
+	ass.Equal(t, "number", expressions.GetNext().GetLowercase())
+	ass.False(t, expressions.HasNext())
+	gra.Validator().Make().ValidateSyntax(syntax)
+
+	// Clashing names, missing files and import cycles are all reported.
+	defer func() {
+		var message = recover().(string)
+		var common = fil.Join(first, "Common.cdsn")
+		var loop = fil.Join(second, "Loop.cdsn")
+		ass.Equal(
+			t,
+			`The imports contain the following problems:
+  `+common+`:15:1: The expression "name" defined in "`+common+`" clashes with the one defined in the importing syntax.
+  6:1: The syntax file "Missing.cdsn" imported by the importing syntax was not found on the search path.
+  `+loop+`:5:1: The syntax file "`+loop+`" imports itself: `+loop+` -> `+loop,
+			message,
+		)
+	}()
+	resolver.ResolveSyntax(parser.ParseSource(clashingSyntax))
+}
+
+const librarySyntax = `!>
+LIBRARY
+<!
+
+!>
+RULES
+<!
+Pair: name number
+
+Triple: name number number
+
+!>
+EXPRESSIONS
+<!
+name: LOWER+
+
+number: DIGIT+
+
+word: UPPER+
+
+`
+
+const faultySyntax = `!>
+FAULTY
+<!
+
+!>
+RULES
+<!
+Pair: name count
+
+!>
+EXPRESSIONS
+<!
+name: LOWER+
+
+`
+
+const partialSyntax = `!>
+NOTICE
+<!
+
+@"Library.cdsn"
+
+!>
+RULES
+<!
+Syntax: Pair+
+
+!>
+EXPRESSIONS
+<!
+`
+
+const faultyImportSyntax = `!>
+NOTICE
+<!
+
+@"Faulty.cdsn"
+
+!>
+RULES
+<!
+Syntax: Pair+
+
+!>
+EXPRESSIONS
+<!
+`
+
+func TestPartialImport(t *tes.T) {
+	var directory = t.TempDir()
+	var files = map[string]string{
+		"Library.cdsn": librarySyntax,
+		"Faulty.cdsn":  faultySyntax,
+	}
+	for name, source := range files {
+		var err = osx.WriteFile(fil.Join(directory, name), []byte(source), 0644)
+		if err != nil {
+			panic(err)
+		}
+	}
+	var directories = col.List[string]([]string{directory})
+	var resolver = gen.Resolver().Make(directories)
+	var parser = gra.Parser().Make()
+	var validator = gra.Validator().Make()
+
+	// The imported definitions that the importing syntax never uses are not
+	// reported as unreachable.
+	var syntax = resolver.ResolveSyntax(parser.ParseSource(partialSyntax))
+	ass.Equal(t, 3, syntax.GetRules().GetSize())
+	ass.Equal(t, 3, syntax.GetExpressions().GetSize())
+	validator.ValidateSyntax(syntax)
+
+	// The problems within an imported definition name the imported file.
+	defer func() {
+		var message = recover().(string)
+		var faulty = fil.Join(directory, "Faulty.cdsn")
+		ass.Equal(
+			t,
+			`The syntax contains the following problems:
+  `+faulty+`:8:12: The expression "count" is referenced but never defined.`,
+			message,
+		)
+	}()
+	validator.ValidateSyntax(resolver.ResolveSyntax(parser.ParseSource(faultyImportSyntax)))
+}
+
+const existingSource = `package example
+
+import (
+	fmt "fmt"
+	sts "strings"
+	uni "unicode"
+)
+
+type example_ struct {
+	name_ string
+}
+
+func (v *example_) GetName() string {
+	return v.name_
+}
+
+// GetLength returns the length of the name.
+//
+//cdsn:preserve
+func (v *example_) GetLength() int {
+	return len(sts.TrimSpace(v.name_))
+}
+
+// GetStatus is a stale generated method.
+func (v *example_) GetStatus() string {
+	return fmt.Sprintf("%v", v.name_)
+}
+
+//cdsn:preserve
+func (v *example_) isUpper() bool {
+	return uni.IsUpper([]rune(v.name_)[0])
+}
+`
+
+const generatedSource = `package example
+
+import (
+	fmt "fmt"
+)
+
+type example_ struct {
+	name_ string
+}
+
+func (v *example_) GetName() string {
+	return fmt.Sprint(v.name_)
+}
+
+func (v *example_) GetLength() int {
+	// TBD - Add the method implementation.
+	return 0
+}
+
+func (v *example_) GetStatus() string {
+	return "ready"
+}
+`
+
+const mergedSource = `package example
+
+import (
+	fmt "fmt"
+	sts "strings"
+	uni "unicode"
 )
 
+type example_ struct {
+	name_ string
+}
+
+func (v *example_) GetName() string {
+	return fmt.Sprint(v.name_)
+}
+
+// GetLength returns the length of the name.
+//
+//cdsn:preserve
+func (v *example_) GetLength() int {
+	return len(sts.TrimSpace(v.name_))
+}
+
+func (v *example_) GetStatus() string {
+	return "ready"
+}
+
+//cdsn:preserve
+func (v *example_) isUpper() bool {
+	return uni.IsUpper([]rune(v.name_)[0])
+}
+`
+
+func TestMerger(t *tes.T) {
+	var merger = gen.Merger().Make()
+
+	// Only the marked declarations and the imports they use are preserved.
+	var merged = merger.MergeSource(existingSource, generatedSource)
+	ass.Equal(t, mergedSource, merged)
+
+	// Merging again is stable, and nothing is kept without a marker.
+	ass.Equal(t, mergedSource, merger.MergeSource(merged, generatedSource))
+	ass.Equal(t, generatedSource, merger.MergeSource(generatedSource, generatedSource))
+}
+
+const appendedSyntax = `!>
+NOTICE
+<!
+
+!>
+RULES
+<!
+Syntax: Pair+
+
+Pair: word "=" number tag?
+
+!>
+EXPRESSIONS
+<!
+number: DIGIT+
+
+word: LOWER+
+
+tag: "#" LOWER+
+
+`
+
+func TestTokenTypes(t *tes.T) {
+	// A token type whose expression is appended to the syntax is enumerated
+	// after the existing ones.
+	var syntax = gra.Parser().Make().ParseSource(appendedSyntax)
+	var implementation = gen.Grammar().Make().GenerateGrammarModel("example", "", syntax)
+	ass.Contains(t, implementation, `
+	ErrorToken TokenType = iota
+	DelimiterToken
+	NewlineToken
+	NumberToken
+	SpaceToken
+	WordToken
+	TagToken
+	KeywordToken
+)`)
+}
+
+const customSyntax = `!>
+<Copyright>
+<!
+
+!>
+<SYNTAX> NOTATION
+The <Syntax> Notation.
+<!
+Document: Component newline+
+
+Component: text
+
+!>
+EXPRESSIONS
+<!
+text: '"' ~['"' CONTROL]+ '"'
+`
+
+func TestTemplates(t *tes.T) {
+	var templates = gen.Templates().Make()
+	var names = templates.GetNames().AsArray()
+	ass.Contains(t, names, "parser/classTemplate")
+	ass.Contains(t, names, "scanner/modeMethodTemplate")
+	ass.Contains(t, names, "syntax/syntaxTemplate")
+
+	// Only the placeholders filled in by a generator are required.
+	var placeholders = templates.GetPlaceholders("parser/classTemplate").AsArray()
+	ass.Contains(t, placeholders, "<SyntaxName>")
+	ass.Contains(t, placeholders, "<Methods>")
+	ass.NotContains(t, placeholders, "<Notice>")
+	ass.NotContains(t, placeholders, "<EOF>")
+
+	// A replacement may use any case form of each required placeholder.
+	var syntax = gen.Syntax().MakeWithTemplates(templates)
+	templates.SetTemplate("syntax/syntaxTemplate", customSyntax)
+	var source = syntax.GenerateSyntaxNotation("example", "Copyright (c) ACME.")
+	ass.True(t, sts.HasPrefix(source, "!>\n"))
+	ass.Contains(t, source, "EXAMPLE NOTATION\nThe Example Notation.")
+	ass.NotContains(t, source, "DO NOT ALTER")
+
+	// Replacements can be loaded from a file system.
+	var directory = fst.MapFS{
+		"token/classTemplate.go.tmpl": &fst.MapFile{
+			Data: []byte("package grammar\n"),
+		},
+	}
+	templates.LoadTemplates(directory)
+	ass.Equal(t, "package grammar\n", templates.GetTemplate("token/classTemplate"))
+	var token = gen.Token().MakeWithTemplates(templates)
+	var parsed = gra.Parser().Make().ParseSource(source)
+	ass.Equal(t, "package grammar\n", token.GenerateTokenClass("example", parsed))
+
+	// Missing placeholders and unknown templates are both reported.
+	defer func() {
+		ass.Equal(t, `The template "visitor/missingTemplate" is not defined.`, recover())
+	}()
+	func() {
+		defer func() {
+			ass.Equal(
+				t,
+				`The template "parser/classTemplate" is missing the following required placeholders: <SyntaxName>, <Methods>`,
+				recover(),
+			)
+		}()
+		var template = templates.GetTemplate("parser/classTemplate")
+		template = sts.ReplaceAll(template, "<SyntaxName>", "")
+		template = sts.ReplaceAll(template, "<syntaxName>", "")
+		template = sts.ReplaceAll(template, "<Methods>", "")
+		templates.SetTemplate("parser/classTemplate", template)
+	}()
+	templates.GetTemplate("visitor/missingTemplate")
+}
+
+const firstSyntax = `!>
+FIRST
+<!
+
+@"Second.cdsn"
+
+!>
+RULES
+<!
+First: second
+
+!>
+EXPRESSIONS
+<!
+first: LOWER+
+
+`
+
+const secondSyntax = `!>
+SECOND
+<!
+
+@"First.cdsn"
+@"Broken.cdsn"
+
+!>
+RULES
+<!
+!>
+EXPRESSIONS
+<!
+second: DIGIT+
+
+`
+
+const brokenSyntax = `!>
+BROKEN
+<!
+
+!>
+RULES
+<!
+Broken: /
+`
+
+func TestImportCycle(t *tes.T) {
+	var directory = t.TempDir()
+	var files = map[string]string{
+		"First.cdsn":  firstSyntax,
+		"Second.cdsn": secondSyntax,
+		"Broken.cdsn": brokenSyntax,
+	}
+	for name, source := range files {
+		var err = osx.WriteFile(fil.Join(directory, name), []byte(source), 0644)
+		if err != nil {
+			panic(err)
+		}
+	}
+	var directories = col.List[string]([]string{directory})
+	var resolver = gen.Resolver().Make(directories)
+	var syntax = gra.Parser().Make().ParseSource(firstSyntax)
+
+	// An import cycle back to the importing file is reported as a cycle, and
+	// the parse errors in an imported file include their messages.
+	defer func() {
+		var message = recover().(string)
+		var first = fil.Join(directory, "First.cdsn")
+		var second = fil.Join(directory, "Second.cdsn")
+		var broken = fil.Join(directory, "Broken.cdsn")
+		ass.Contains(
+			t,
+			message,
+			"\n  "+second+`:5:1: The syntax file "`+first+`" imports itself: `+first+` -> `+second+` -> `+first,
+		)
+		ass.Contains(t, message, "\n  "+broken+":8:9: unexpected ")
+		ass.NotContains(t, message, broken+":8:9: \n")
+	}()
+	resolver.ResolveSyntaxFile(fil.Join(directory, "First.cdsn"), syntax)
+}
+
 func TestLifecycle(t *tes.T) {
 	var module = "github.com/craterdog/go-test-framework/v4"
 	var wiki = "github.com/craterdog/go-test-framework/wiki"
@@ -260,6 +1605,7 @@ const formatterClass = `/*
 package grammar
 
 import (
+	col "github.com/craterdog/go-collection-framework/v4"
 	fmt "fmt"
 	col "github.com/craterdog/go-collection-framework/v4"
 	abs "github.com/craterdog/go-collection-framework/v4/collection"
@@ -438,6 +1784,7 @@ const parserClass = `/*
 package grammar
 
 import (
+	col "github.com/craterdog/go-collection-framework/v4"
 	fmt "fmt"
 	col "github.com/craterdog/go-collection-framework/v4"
 	abs "github.com/craterdog/go-collection-framework/v4/collection"
@@ -942,6 +2289,7 @@ const scannerClass = `/*
 package grammar
 
 import (
+	col "github.com/craterdog/go-collection-framework/v4"
 	fmt "fmt"
 	abs "github.com/craterdog/go-collection-framework/v4/collection"
 	reg "regexp"
@@ -1284,6 +2632,7 @@ const validatorClass = `/*
 package grammar
 
 import (
+	col "github.com/craterdog/go-collection-framework/v4"
 	fmt "fmt"
 	col "github.com/craterdog/go-collection-framework/v4"
 	abs "github.com/craterdog/go-collection-framework/v4/collection"
@@ -1408,6 +2757,7 @@ const processorClass = `/*
 package grammar
 
 import (
+	col "github.com/craterdog/go-collection-framework/v4"
 	ast "github.com/craterdog/go-grammar-framework/v4/ast"
 )
 
@@ -1530,6 +2880,7 @@ const visitorClass = `/*
 package grammar
 
 import (
+	col "github.com/craterdog/go-collection-framework/v4"
 	fmt "fmt"
 	col "github.com/craterdog/go-collection-framework/v4"
 	ast "github.com/craterdog/go-grammar-framework/v4/ast"
@@ -1735,6 +3086,7 @@ on interfaces, not on each other.
 package grammar
 
 import (
+	col "github.com/craterdog/go-collection-framework/v4"
 	abs "github.com/craterdog/go-collection-framework/v4/collection"
 	ast "github.com/craterdog/go-grammar-framework/v4/ast"
 )
@@ -2010,6 +3362,7 @@ on interfaces, not on each other.
 package ast
 
 import (
+	col "github.com/craterdog/go-collection-framework/v4"
 	abs "github.com/craterdog/go-collection-framework/v4/collection"
 )
 
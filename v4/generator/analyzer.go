@@ -13,12 +13,17 @@
 package generator
 
 import (
+	fmt "fmt"
 	col "github.com/craterdog/go-collection-framework/v4"
 	abs "github.com/craterdog/go-collection-framework/v4/collection"
 	ast "github.com/craterdog/go-grammar-framework/v4/ast"
 	gra "github.com/craterdog/go-grammar-framework/v4/grammar"
+	reg "regexp"
+	syn "regexp/syntax"
 	stc "strconv"
 	sts "strings"
+	uni "unicode"
+	utf "unicode/utf8"
 )
 
 // CLASS ACCESS
@@ -68,9 +73,14 @@ type analyzer_ struct {
 	isGreedy_     bool
 	inDefinition_ bool
 	inPattern_    bool
+	inLookahead_  bool
+	inLabel_      bool
+	inFilter_     bool
+	isIndented_   bool
 	hasLiteral_   bool
 	syntaxMap_    string
 	syntaxName_   string
+	mode_         string
 	notice_       string
 	ruleName_     string
 	regexp_       string
@@ -78,11 +88,24 @@ type analyzer_ struct {
 	tokenNames_   abs.SetLike[string]
 	pluralNames_  abs.SetLike[string]
 	delimited_    abs.SetLike[string]
-	delimiters_   abs.SetLike[string]
+	delimiters_   abs.CatalogLike[string, string]
+	keywords_     abs.CatalogLike[string, string]
+	caseless_     abs.SetLike[string]
 	regexps_      abs.CatalogLike[string, string]
 	terms_        abs.CatalogLike[string, abs.ListLike[ast.TermLike]]
 	references_   abs.CatalogLike[string, abs.ListLike[ast.ReferenceLike]]
 	identifiers_  abs.CatalogLike[string, abs.ListLike[ast.IdentifierLike]]
+	patterns_     abs.CatalogLike[string, ast.PatternLike]
+	expressions_  abs.CatalogLike[string, ast.ExpressionLike]
+	precedences_  abs.CatalogLike[string, ast.PrecedenceLike]
+	operations_   abs.CatalogLike[string, string]
+	modeNames_    abs.ListLike[string]
+	modes_        abs.CatalogLike[string, string] // The named mode of each expression.
+	transitions_  abs.CatalogLike[string, ast.TransitionLike]
+	nullables_    abs.SetLike[string] // The rules and expressions that may match nothing.
+	firsts_       abs.CatalogLike[string, abs.SetLike[string]]
+	follows_      abs.CatalogLike[string, abs.SetLike[string]]
+	warnings_     abs.ListLike[string]
 
 	// Define the inherited aspects.
 	gra.Methodical
@@ -98,18 +121,46 @@ func (v *analyzer_) AnalyzeSyntax(syntax ast.SyntaxLike) {
 	v.visitor_.VisitSyntax(syntax)
 }
 
+func (v *analyzer_) GetCaselessDelimiters() abs.Sequential[string] {
+	return v.caseless_
+}
+
 func (v *analyzer_) GetExpressions() abs.Sequential[abs.AssociationLike[string, string]] {
 	return v.regexps_
 }
 
+func (v *analyzer_) GetFirst(ruleName string) abs.Sequential[string] {
+	return v.firsts_.GetValue(ruleName)
+}
+
+func (v *analyzer_) GetFollow(ruleName string) abs.Sequential[string] {
+	return v.follows_.GetValue(ruleName)
+}
+
 func (v *analyzer_) GetIdentifiers(ruleName string) abs.Sequential[ast.IdentifierLike] {
 	return v.identifiers_.GetValue(ruleName)
 }
 
+func (v *analyzer_) GetMode(tokenName string) string {
+	return v.modes_.GetValue(tokenName)
+}
+
+func (v *analyzer_) GetModes() abs.Sequential[string] {
+	return v.modeNames_
+}
+
 func (v *analyzer_) GetNotice() string {
 	return v.notice_
 }
 
+func (v *analyzer_) GetPrecedence(ruleName string) ast.PrecedenceLike {
+	return v.precedences_.GetValue(ruleName)
+}
+
+func (v *analyzer_) GetPrecedenceRule(className string) string {
+	return v.operations_.GetValue(className)
+}
+
 func (v *analyzer_) GetReferences(ruleName string) abs.Sequential[ast.ReferenceLike] {
 	return v.references_.GetValue(ruleName)
 }
@@ -130,14 +181,34 @@ func (v *analyzer_) GetTerms(ruleName string) abs.Sequential[ast.TermLike] {
 	return v.terms_.GetValue(ruleName)
 }
 
+func (v *analyzer_) GetWarnings() abs.Sequential[string] {
+	return v.warnings_
+}
+
 func (v *analyzer_) GetTokenNames() abs.Sequential[string] {
 	return v.tokenNames_
 }
 
+func (v *analyzer_) GetTransition(tokenName string) ast.TransitionLike {
+	return v.transitions_.GetValue(tokenName)
+}
+
+func (v *analyzer_) HasKeywords() bool {
+	return !v.keywords_.IsEmpty()
+}
+
 func (v *analyzer_) IsDelimited(ruleName string) bool {
 	return v.delimited_.ContainsValue(ruleName)
 }
 
+func (v *analyzer_) IsIndented() bool {
+	return v.isIndented_
+}
+
+func (v *analyzer_) IsNullable(name string) bool {
+	return v.nullables_.ContainsValue(name)
+}
+
 func (v *analyzer_) IsPlural(name string) bool {
 	return v.pluralNames_.ContainsValue(name)
 }
@@ -155,28 +226,53 @@ func (v *analyzer_) ProcessGlyph(glyph string) {
 }
 
 func (v *analyzer_) ProcessIntrinsic(intrinsic string) {
+	if sts.HasPrefix(intrinsic, "UNICODE(") {
+		// The named unicode category or script is matched directly.
+		var name = sts.TrimSuffix(sts.TrimPrefix(intrinsic, "UNICODE("), ")")
+		v.regexp_ += `\\p{` + name + `}`
+		return
+	}
 	intrinsic = sts.ToLower(intrinsic)
-	if intrinsic == "any" {
+	switch intrinsic {
+	case "any":
 		v.isGreedy_ = false // Turn off "greedy" for expressions containing ANY.
+	case "space":
+		// The whitespace characters form a character class of their own.
+		if !v.inFilter_ {
+			v.regexp_ += `[" + white_ + "]`
+			return
+		}
+		intrinsic = "white" // The "space_" pattern is the implicit space token.
 	}
 	v.regexp_ += `" + ` + intrinsic + `_ + "`
 }
 
 func (v *analyzer_) ProcessLiteral(literal string) {
 	v.hasLiteral_ = true
-	var delimiter, err = stc.Unquote(literal) // Remove the double quotes.
-	if err != nil {
-		panic(err)
+	var text, isCaseless = unquoteLiteral(literal)
+	var delimiter = v.escapeText(text)
+	var fragment = delimiter
+	if isCaseless {
+		fragment = "(?i:" + delimiter + ")"
 	}
-	delimiter = v.escapeText(delimiter)
 	if v.inDefinition_ {
-		v.delimiters_.AddValue(delimiter)
+		// The delimiters are keyed by their text so that they sort correctly.
+		if isCaseless {
+			v.caseless_.AddValue(text)
+		}
+		var delimiters = v.delimiters_
+		if isKeyword(text) {
+			delimiters = v.keywords_ // Keywords are scanned as their own token type.
+		}
+		if isCaseless || col.IsUndefined(delimiters.GetValue(delimiter)) {
+			delimiters.SetValue(delimiter, fragment)
+		}
 	}
-	v.regexp_ += delimiter
+	v.regexp_ += fragment
 }
 
 func (v *analyzer_) ProcessLowercase(lowercase string) {
-	if v.inDefinition_ {
+	if v.inDefinition_ && !v.inLabel_ {
 		v.tokenNames_.AddValue(lowercase)
 	}
 	if v.inPattern_ {
@@ -192,6 +288,10 @@ func (v *analyzer_) ProcessOptional(optional string) {
 	v.regexp_ += optional
 }
 
+func (v *analyzer_) ProcessPredicate(predicate string) {
+	v.syntaxMap_ += predicate
+}
+
 func (v *analyzer_) ProcessRepeated(repeated string) {
 	v.regexp_ += repeated
 }
@@ -225,6 +325,10 @@ func (v *analyzer_) PreprocessExpression(
 	size uint,
 ) {
 	v.regexp_ = `"(?:`
+	if col.IsDefined(expression.GetOptionalCaseless()) {
+		// The entire pattern is matched without regard to case.
+		v.regexp_ = `"(?i:`
+	}
 }
 
 func (v *analyzer_) PostprocessExpression(
@@ -235,6 +339,15 @@ func (v *analyzer_) PostprocessExpression(
 	v.regexp_ += `)"`
 	var name = expression.GetLowercase()
 	v.regexps_.SetValue(name, v.regexp_)
+	v.patterns_.SetValue(name, expression.GetPattern())
+	v.expressions_.SetValue(name, expression)
+	if len(v.mode_) > 0 {
+		v.modes_.SetValue(name, v.mode_)
+	}
+	var transition = expression.GetOptionalTransition()
+	if col.IsDefined(transition) {
+		v.transitions_.SetValue(name, transition)
+	}
 }
 
 func (v *analyzer_) PreprocessExtent(extent ast.ExtentLike) {
@@ -242,11 +355,13 @@ func (v *analyzer_) PreprocessExtent(extent ast.ExtentLike) {
 }
 
 func (v *analyzer_) PreprocessFilter(filter ast.FilterLike) {
+	v.inFilter_ = true
 	v.regexp_ += "["
 }
 
 func (v *analyzer_) PostprocessFilter(filter ast.FilterLike) {
 	v.regexp_ += "]"
+	v.inFilter_ = false
 }
 
 func (v *analyzer_) PreprocessGroup(group ast.GroupLike) {
@@ -290,6 +405,56 @@ func (v *analyzer_) PreprocessLine(
 	}
 }
 
+func (v *analyzer_) PreprocessLookahead(lookahead ast.LookaheadLike) {
+	v.inLookahead_ = true
+}
+
+func (v *analyzer_) PostprocessLookahead(lookahead ast.LookaheadLike) {
+	v.inLookahead_ = false
+}
+
+func (v *analyzer_) PreprocessLabel(label ast.LabelLike) {
+	// A label names an attribute rather than a token.
+	v.inLabel_ = true
+}
+
+func (v *analyzer_) PostprocessLabel(label ast.LabelLike) {
+	v.inLabel_ = false
+}
+
+func (v *analyzer_) PreprocessLevel(
+	level ast.LevelLike,
+	index uint,
+	size uint,
+) {
+	v.syntaxMap_ += "\n  " + level.GetAssociativity()
+	var operators = level.GetOperators().GetIterator()
+	for operators.HasNext() {
+		v.syntaxMap_ += " " + operators.GetNext().GetLiteral()
+	}
+	var note = level.GetOptionalNote()
+	if col.IsDefined(note) {
+		v.syntaxMap_ += "  " + note
+	}
+}
+
+func (v *analyzer_) PreprocessMode(
+	mode ast.ModeLike,
+	index uint,
+	size uint,
+) {
+	v.mode_ = mode.GetLowercase()
+	v.modeNames_.AppendValue(v.mode_)
+}
+
+func (v *analyzer_) PostprocessMode(
+	mode ast.ModeLike,
+	index uint,
+	size uint,
+) {
+	v.mode_ = "" // The remaining expressions are in the default mode.
+}
+
 func (v *analyzer_) PreprocessPattern(definition ast.PatternLike) {
 	v.inPattern_ = true
 }
@@ -298,6 +463,15 @@ func (v *analyzer_) PostprocessPattern(definition ast.PatternLike) {
 	v.inPattern_ = false
 }
 
+func (v *analyzer_) PreprocessPrecedence(precedence ast.PrecedenceLike) {
+	var identifier = precedence.GetIdentifier()
+	v.syntaxMap_ += "% " + identifier.GetAny().(string)
+	var note = precedence.GetOptionalNote()
+	if col.IsDefined(note) {
+		v.syntaxMap_ += "  " + note
+	}
+}
+
 func (v *analyzer_) PreprocessQuantified(quantified ast.QuantifiedLike) {
 	v.regexp_ += "{"
 }
@@ -311,8 +485,17 @@ func (v *analyzer_) PostprocessQuantified(quantified ast.QuantifiedLike) {
 }
 
 func (v *analyzer_) PreprocessReference(reference ast.ReferenceLike) {
-	var references = v.references_.GetValue(v.ruleName_)
-	references.AppendValue(reference)
+	if !v.inLookahead_ {
+		// A lookahead reference does not become an attribute of the rule.
+		var references = v.references_.GetValue(v.ruleName_)
+		references.AppendValue(reference)
+	}
+
+	// Process the label.
+	var label = reference.GetOptionalLabel()
+	if col.IsDefined(label) {
+		v.syntaxMap_ += label.GetLowercase() + ":"
+	}
 
 	// Process the identifier.
 	var identifier = reference.GetIdentifier()
@@ -334,11 +517,18 @@ func (v *analyzer_) PreprocessReference(reference ast.ReferenceLike) {
 				v.syntaxMap_ += ".."
 				var last = limit.GetOptionalNumber()
 				if col.IsDefined(last) {
-					v.syntaxMap_ += last + "}"
+					v.syntaxMap_ += last
 				}
 			}
+			v.syntaxMap_ += "}"
 		}
 	}
+
+	// Process the separator.
+	var separator = reference.GetOptionalSeparator()
+	if col.IsDefined(separator) {
+		v.syntaxMap_ += " / " + separator.GetLiteral() + separator.GetOptionalTrailing()
+	}
 }
 
 func (v *analyzer_) PreprocessRule(
@@ -351,7 +541,7 @@ func (v *analyzer_) PreprocessRule(
 	v.ruleName_ = ruleName
 	v.ruleNames_.AddValue(ruleName)
 	var definition = rule.GetDefinition()
-	switch definition.GetAny().(type) {
+	switch actual := definition.GetAny().(type) {
 	case ast.InlineLike:
 		var terms = col.List[ast.TermLike]()
 		v.terms_.SetValue(ruleName, terms)
@@ -360,6 +550,16 @@ func (v *analyzer_) PreprocessRule(
 	case ast.MultilineLike:
 		var identifiers = col.List[ast.IdentifierLike]()
 		v.identifiers_.SetValue(ruleName, identifiers)
+	case ast.PrecedenceLike:
+		// The rule is either a single operand or a binary operation.
+		var operation = ruleName + "Operation"
+		var identifiers = col.List[ast.IdentifierLike]()
+		identifiers.AppendValue(actual.GetIdentifier())
+		identifiers.AppendValue(ast.Identifier().Make(operation))
+		v.identifiers_.SetValue(ruleName, identifiers)
+		v.precedences_.SetValue(ruleName, actual)
+		v.operations_.SetValue(operation, ruleName)
+		v.ruleNames_.AddValue(operation)
 	}
 	v.syntaxMap_ += "\n\t\t\"" + ruleName + "\": `"
 }
@@ -383,33 +583,53 @@ func (v *analyzer_) PreprocessSyntax(syntax ast.SyntaxLike) {
 	v.syntaxName_ = v.extractSyntaxName(syntax)
 	v.notice_ = v.extractNotice(syntax)
 	v.ruleNames_ = col.Set[string]()
-	v.tokenNames_ = col.Set[string]([]string{"delimiter", "newline", "space"})
+	v.tokenNames_ = col.Set[string]([]string{"delimiter", "keyword", "newline", "space"})
 	v.pluralNames_ = col.Set[string]()
 	v.delimited_ = col.Set[string]()
-	v.delimiters_ = col.Set[string]()
+	v.delimiters_ = col.Catalog[string, string]()
+	v.keywords_ = col.Catalog[string, string]()
+	v.caseless_ = col.Set[string]()
 	var implicit = map[string]string{
 		"newline": `"(?:\\r?\\n)"`,
 		"space":   `"(?:[ \\t]+)"`,
 	}
 	v.regexps_ = col.Catalog[string, string](implicit)
+	v.isIndented_ = false
+	var directives = syntax.GetDirectives().GetIterator()
+	for directives.HasNext() {
+		if directives.GetNext().GetLowercase() == "indentation" {
+			// The indentation tokens are synthesized rather than matched, so
+			// their patterns only match the canonical values that they are given.
+			v.isIndented_ = true
+			v.tokenNames_.AddValues(col.List[string]([]string{"dedent", "indent"}))
+			v.regexps_.SetValue("dedent", `"(?:<DEDENT>)"`)
+			v.regexps_.SetValue("indent", `"(?:<INDENT>)"`)
+		}
+	}
 	v.terms_ = col.Catalog[string, abs.ListLike[ast.TermLike]]()
 	v.references_ = col.Catalog[string, abs.ListLike[ast.ReferenceLike]]()
 	v.identifiers_ = col.Catalog[string, abs.ListLike[ast.IdentifierLike]]()
+	v.patterns_ = col.Catalog[string, ast.PatternLike]()
+	v.expressions_ = col.Catalog[string, ast.ExpressionLike]()
+	v.precedences_ = col.Catalog[string, ast.PrecedenceLike]()
+	v.operations_ = col.Catalog[string, string]()
+	v.mode_ = ""
+	v.modeNames_ = col.List[string]()
+	v.modes_ = col.Catalog[string, string]()
+	v.transitions_ = col.Catalog[string, ast.TransitionLike]()
 }
 
 func (v *analyzer_) PostprocessSyntax(syntax ast.SyntaxLike) {
-	var delimiters = `"(?:`
-	if !v.delimiters_.IsEmpty() {
-		var iterator = v.delimiters_.GetIterator()
-		iterator.ToEnd() // These must be assembled in reverse alphabetical order.
-		delimiters += iterator.GetPrevious()
-		for iterator.HasPrevious() {
-			delimiters += "|" + iterator.GetPrevious()
-		}
+	v.regexps_.SetValue("delimiter", v.alternateFragments(v.delimiters_))
+	if !v.keywords_.IsEmpty() {
+		v.regexps_.SetValue("keyword", v.alternateFragments(v.keywords_))
 	}
-	delimiters += `)"`
-	v.regexps_.SetValue("delimiter", delimiters)
 	v.regexps_.SortValues()
+	v.findNullables(syntax)
+	v.findFirsts()
+	v.findFollows()
+	v.checkAlternatives()
+	v.checkTokens()
 }
 
 func (v *analyzer_) PreprocessTerm(
@@ -424,12 +644,149 @@ func (v *analyzer_) PreprocessTerm(
 	case string:
 		v.syntaxMap_ += actual
 	}
-	var terms = v.terms_.GetValue(v.ruleName_)
-	terms.AppendValue(term)
+	if !v.inLookahead_ {
+		// The term within a lookahead is part of the lookahead term.
+		var terms = v.terms_.GetValue(v.ruleName_)
+		terms.AppendValue(term)
+	}
 }
 
 // Private
 
+func (v *analyzer_) addFollow(
+	name string,
+	tokens abs.Sequential[string],
+) (
+	found bool,
+) {
+	var follow = v.follows_.GetValue(name)
+	if col.IsUndefined(follow) {
+		// Only rules have follow sets.
+		return found
+	}
+	var size = follow.GetSize()
+	follow.AddValues(tokens)
+	return follow.GetSize() > size
+}
+
+func (v *analyzer_) allowsNone(cardinality ast.CardinalityLike) bool {
+	if col.IsUndefined(cardinality) {
+		return false
+	}
+	switch actual := cardinality.GetAny().(type) {
+	case ast.ConstrainedLike:
+		var constraint = actual.GetAny().(string)
+		return constraint == "?" || constraint == "*"
+	case ast.QuantifiedLike:
+		return actual.GetNumber() == "0"
+	}
+	return false
+}
+
+func (v *analyzer_) allowsOne(cardinality ast.CardinalityLike) bool {
+	// Determine whether the cardinality allows at most one instance.
+	switch actual := cardinality.GetAny().(type) {
+	case ast.ConstrainedLike:
+		return actual.GetAny().(string) == "?"
+	case ast.QuantifiedLike:
+		var limit = actual.GetOptionalLimit()
+		if col.IsUndefined(limit) {
+			return actual.GetNumber() == "1"
+		}
+		var last = limit.GetOptionalNumber()
+		return last == "1" || (last == "0" && actual.GetNumber() == "0")
+	}
+	return true
+}
+
+func (v *analyzer_) alternateFragments(
+	fragments abs.CatalogLike[string, string],
+) string {
+	var regexp = `"(?:`
+	if !fragments.IsEmpty() {
+		fragments.SortValues()
+		var iterator = fragments.GetIterator()
+		iterator.ToEnd() // These must be assembled in reverse alphabetical order.
+		regexp += iterator.GetPrevious().GetValue()
+		for iterator.HasPrevious() {
+			regexp += "|" + iterator.GetPrevious().GetValue()
+		}
+	}
+	regexp += `)"`
+	return regexp
+}
+
+func (v *analyzer_) checkAlternatives() {
+	// The alternatives in a multiline rule are attempted in the order listed.
+	v.warnings_ = col.List[string]()
+	var rules = v.ruleNames_.GetIterator()
+	for rules.HasNext() {
+		var ruleName = rules.GetNext()
+		var identifiers = v.identifiers_.GetValue(ruleName)
+		if col.IsUndefined(identifiers) || col.IsDefined(v.precedences_.GetValue(ruleName)) {
+			// The alternatives of a precedence table are chosen by the operators.
+			continue
+		}
+		var previous = col.Set[string]()
+		var iterator = identifiers.GetIterator()
+		for iterator.HasNext() {
+			var identifier = iterator.GetNext()
+			var name = identifier.GetAny().(string)
+			var first = col.Set[string](v.getFirst(name))
+			var conflicts = previous.GetClass().And(previous, first)
+			if !conflicts.IsEmpty() {
+				var reason = "requires backtracking since earlier alternatives also match"
+				if conflicts.GetSize() == first.GetSize() {
+					reason = "can never be selected since earlier alternatives match"
+				}
+				var warning = fmt.Sprintf(
+					"The %q alternative in the %q rule %v: %v",
+					name,
+					ruleName,
+					reason,
+					sts.Join(conflicts.AsArray(), ", "),
+				)
+				var span = identifier.GetSpan()
+				if col.IsDefined(span) {
+					var start = span.GetStart()
+					warning = fmt.Sprintf("%d:%d: ", start.GetLine(), start.GetColumn()) + warning
+				}
+				v.warnings_.AppendValue(warning)
+			}
+			previous.AddValues(first)
+		}
+	}
+}
+
+func (v *analyzer_) checkKeywords(tokenName string, program *syn.Prog) {
+	// A token that matches only the start of a keyword splits it so that the
+	// keyword literal can never be scanned.
+	var matcher = reg.MustCompile("^(?:" + v.resolveExpression(tokenName) + ")")
+	var keywords = v.keywords_.GetIterator()
+	for keywords.HasNext() {
+		var fragment, err = stc.Unquote(`"` + keywords.GetNext().GetValue() + `"`)
+		if err != nil {
+			panic(err)
+		}
+		var _, keyword, ok = v.findShadowing(program, v.compilePattern(fragment))
+		if !ok {
+			continue
+		}
+		var match = matcher.FindString(keyword)
+		if len(match) == 0 || len(match) == len(keyword) {
+			continue
+		}
+		var warning = fmt.Sprintf(
+			"The %q token cannot match %q since the %q token is scanned first and matches %q.",
+			"keyword",
+			keyword,
+			tokenName,
+			match,
+		)
+		v.warnings_.AppendValue(warning)
+	}
+}
+
 func (v *analyzer_) checkPlurality(
 	name string,
 	cardinality ast.CardinalityLike,
@@ -445,6 +802,115 @@ func (v *analyzer_) checkPlurality(
 	}
 }
 
+func (v *analyzer_) checkTokens() {
+	// The scanner attempts each token type in order and takes the first match.
+	var programs = col.Catalog[string, *syn.Prog]()
+	var tokenNames = v.tokenNames_.GetIterator()
+	for tokenNames.HasNext() {
+		var tokenName = tokenNames.GetNext()
+		programs.SetValue(tokenName, v.compileExpression(tokenName))
+	}
+	var shadowed = v.tokenNames_.GetIterator()
+	for shadowed.HasNext() {
+		var shadowedName = shadowed.GetNext()
+		var shadowing = v.tokenNames_.GetIterator()
+		for shadowing.HasNext() {
+			var shadowingName = shadowing.GetNext()
+			if shadowingName == shadowedName {
+				// Only the tokens that are scanned first can shadow this one.
+				break
+			}
+			if v.modes_.GetValue(shadowingName) != v.modes_.GetValue(shadowedName) {
+				// Tokens in different modes are never scanned at the same time.
+				continue
+			}
+			var program = programs.GetValue(shadowingName)
+			switch {
+			case shadowedName == "keyword":
+				// Any token matching a keyword exactly is rescanned as a keyword.
+				v.checkKeywords(shadowingName, program)
+				continue
+			case shadowingName == "keyword":
+				if v.keywords_.IsEmpty() {
+					continue
+				}
+				// Keywords must be followed by a non-word character.
+				var pattern = v.resolveExpression(shadowingName)
+				program = v.compilePattern("(?:" + pattern + `)[^\p{L}\p{N}_]`)
+			}
+			var prefix, example, ok = v.findShadowing(
+				program,
+				programs.GetValue(shadowedName),
+			)
+			if !ok {
+				continue
+			}
+			if shadowingName == "keyword" {
+				// The non-word character following the keyword is not part of it.
+				var _, size = utf.DecodeLastRuneInString(prefix)
+				prefix = prefix[:len(prefix)-size]
+			}
+			var warning = fmt.Sprintf(
+				"The %q token cannot match %q since the %q token is scanned first and matches %q.",
+				shadowedName,
+				example,
+				shadowingName,
+				prefix,
+			)
+			var expression = v.expressions_.GetValue(shadowedName)
+			if col.IsDefined(expression) && col.IsDefined(expression.GetSpan()) {
+				var start = expression.GetSpan().GetStart()
+				warning = fmt.Sprintf("%d:%d: ", start.GetLine(), start.GetColumn()) + warning
+			}
+			v.warnings_.AppendValue(warning)
+		}
+	}
+}
+
+func (v *analyzer_) closeProgram(program *syn.Prog, pc uint32) []uint32 {
+	// Follow the instructions that do not consume a character.
+	var closure []uint32
+	var visited = map[uint32]bool{}
+	var stack = []uint32{pc}
+	for len(stack) > 0 {
+		pc = stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		if visited[pc] {
+			continue
+		}
+		visited[pc] = true
+		var instruction = &program.Inst[pc]
+		switch instruction.Op {
+		case syn.InstAlt, syn.InstAltMatch:
+			stack = append(stack, instruction.Arg, instruction.Out)
+		case syn.InstCapture, syn.InstNop, syn.InstEmptyWidth:
+			// Assertions are assumed to hold which may report extra overlaps.
+			stack = append(stack, instruction.Out)
+		case syn.InstFail:
+		default:
+			closure = append(closure, pc)
+		}
+	}
+	return closure
+}
+
+func (v *analyzer_) compileExpression(name string) *syn.Prog {
+	return v.compilePattern(v.resolveExpression(name))
+}
+
+func (v *analyzer_) compilePattern(pattern string) *syn.Prog {
+	var regexp, err = syn.Parse(pattern, syn.Perl)
+	if err != nil {
+		panic(err)
+	}
+	var program *syn.Prog
+	program, err = syn.Compile(regexp.Simplify())
+	if err != nil {
+		panic(err)
+	}
+	return program
+}
+
 func (v *analyzer_) escapeText(text string) string {
 	var escaped string
 	for _, character := range text {
@@ -475,8 +941,538 @@ func (v *analyzer_) extractNotice(syntax ast.SyntaxLike) string {
 
 func (v *analyzer_) extractSyntaxName(syntax ast.SyntaxLike) string {
 	var rules = syntax.GetRules().GetIterator()
+	if !rules.HasNext() {
+		// A syntax whose rules are all imported has no name of its own.
+		return ""
+	}
 	// The first rule name is the name of the syntax.
 	var rule = rules.GetNext()
 	var name = rule.GetUppercase()
 	return name
 }
+
+func (v *analyzer_) findCharacter(
+	first *syn.Inst,
+	second *syn.Inst,
+) (
+	character rune,
+	ok bool,
+) {
+	// Look for a character in both ranges, preferring printable characters.
+	var firstRanges = v.getRanges(first)
+	var secondRanges = v.getRanges(second)
+	for i := 0; i < len(firstRanges); i += 2 {
+		for j := 0; j < len(secondRanges); j += 2 {
+			var low = max(firstRanges[i], secondRanges[j])
+			var high = min(firstRanges[i+1], secondRanges[j+1])
+			if low > high {
+				continue
+			}
+			for _, candidate := range []rune{'a', 'A', '0', '!'} {
+				if low <= candidate && candidate <= high {
+					return candidate, true
+				}
+			}
+			if !ok || !uni.IsPrint(character) {
+				character = low
+				ok = true
+			}
+		}
+	}
+	return character, ok
+}
+
+func (v *analyzer_) findFirsts() {
+	// Repeat until no more tokens are added to the first set of any rule.
+	v.firsts_ = col.Catalog[string, abs.SetLike[string]]()
+	var rules = v.ruleNames_.GetIterator()
+	for rules.HasNext() {
+		v.firsts_.SetValue(rules.GetNext(), col.Set[string]())
+	}
+	var found = true
+	for found {
+		found = false
+		rules.ToStart()
+		for rules.HasNext() {
+			var ruleName = rules.GetNext()
+			var first = v.firsts_.GetValue(ruleName)
+			var size = first.GetSize()
+			var identifiers = v.identifiers_.GetValue(ruleName)
+			var precedenceRule = v.operations_.GetValue(ruleName)
+			switch {
+			case col.IsDefined(identifiers):
+				var iterator = identifiers.GetIterator()
+				for iterator.HasNext() {
+					var name = iterator.GetNext().GetAny().(string)
+					first.AddValues(col.List[string](v.getFirst(name).AsArray()))
+				}
+			case col.IsDefined(precedenceRule):
+				// A binary operation begins with its left operand.
+				first.AddValues(col.List[string](v.getFirst(precedenceRule).AsArray()))
+			default:
+				var terms = v.terms_.GetValue(ruleName).AsArray()
+				var rest, _ = v.getFirstOfTerms(terms)
+				first.AddValues(rest)
+			}
+			if first.GetSize() > size {
+				found = true
+			}
+		}
+	}
+}
+
+func (v *analyzer_) findFollows() {
+	// The syntax rule is followed by the end of the source.
+	v.follows_ = col.Catalog[string, abs.SetLike[string]]()
+	var rules = v.ruleNames_.GetIterator()
+	for rules.HasNext() {
+		v.follows_.SetValue(rules.GetNext(), col.Set[string]())
+	}
+	if v.ruleNames_.ContainsValue(v.syntaxName_) {
+		v.follows_.GetValue(v.syntaxName_).AddValue("<EOF>")
+	}
+
+	// Repeat until no more tokens are added to the follow set of any rule.
+	var found = true
+	for found {
+		found = false
+		rules.ToStart()
+		for rules.HasNext() {
+			var ruleName = rules.GetNext()
+			var follow = col.List[string](v.follows_.GetValue(ruleName).AsArray())
+			var identifiers = v.identifiers_.GetValue(ruleName)
+			if col.IsDefined(identifiers) {
+				// Each alternative is followed by whatever follows the rule.
+				var iterator = identifiers.GetIterator()
+				for iterator.HasNext() {
+					var name = iterator.GetNext().GetAny().(string)
+					found = v.addFollow(name, follow) || found
+				}
+				var precedence = v.precedences_.GetValue(ruleName)
+				if col.IsDefined(precedence) {
+					// An operand may also be followed by any of the operators.
+					var operand = precedence.GetIdentifier().GetAny().(string)
+					found = v.addFollow(operand, v.getOperators(precedence)) || found
+				}
+				continue
+			}
+			if col.IsDefined(v.operations_.GetValue(ruleName)) {
+				// The operands of a binary operation are handled by the precedence rule.
+				continue
+			}
+			var terms = v.terms_.GetValue(ruleName).AsArray()
+			for index, term := range terms {
+				var reference, ok = term.GetAny().(ast.ReferenceLike)
+				if !ok {
+					continue
+				}
+				var name = reference.GetIdentifier().GetAny().(string)
+
+				// A reference is followed by whatever may start the remaining terms.
+				var rest, isNullable = v.getFirstOfTerms(terms[index+1:])
+				found = v.addFollow(name, rest) || found
+				if isNullable {
+					found = v.addFollow(name, follow) || found
+				}
+
+				// A repeated reference may also be followed by itself or its separator.
+				var cardinality = reference.GetOptionalCardinality()
+				if col.IsDefined(cardinality) && !v.allowsOne(cardinality) {
+					var next = v.getFirst(name)
+					var separator = reference.GetOptionalSeparator()
+					if col.IsDefined(separator) {
+						next = col.List[string]([]string{separator.GetLiteral()})
+					}
+					found = v.addFollow(name, next) || found
+				}
+			}
+		}
+	}
+}
+
+func (v *analyzer_) findMatch(
+	program *syn.Prog,
+	pcs []uint32,
+) (
+	suffix []rune,
+	ok bool,
+) {
+	// Search breadth first for the shortest path to a match.
+	var visited = map[uint32]bool{}
+	var witnesses = map[uint32][]rune{}
+	var queue = pcs
+	for _, pc := range pcs {
+		visited[pc] = true
+	}
+	for len(queue) > 0 {
+		var pc = queue[0]
+		queue = queue[1:]
+		var instruction = &program.Inst[pc]
+		if instruction.Op == syn.InstMatch {
+			return witnesses[pc], true
+		}
+		var character, _ = v.findCharacter(instruction, instruction)
+		for _, next := range v.closeProgram(program, instruction.Out) {
+			if visited[next] {
+				continue
+			}
+			visited[next] = true
+			witnesses[next] = append(append([]rune{}, witnesses[pc]...), character)
+			queue = append(queue, next)
+		}
+	}
+	return suffix, ok
+}
+
+func (v *analyzer_) findNullables(syntax ast.SyntaxLike) {
+	var definitions = col.Catalog[string, ast.DefinitionLike]()
+	var rules = syntax.GetRules().GetIterator()
+	for rules.HasNext() {
+		var rule = rules.GetNext()
+		var name = rule.GetUppercase()
+		if col.IsUndefined(definitions.GetValue(name)) {
+			definitions.SetValue(name, rule.GetDefinition())
+		}
+	}
+
+	// Repeat until no more nullable rules or expressions are found.  Any
+	// undefined rule or expression is treated as one that matches something.
+	v.nullables_ = col.Set[string]()
+	var found = true
+	for found {
+		found = false
+		var iterator = definitions.GetIterator()
+		for iterator.HasNext() {
+			var association = iterator.GetNext()
+			var name = association.GetKey()
+			var definition = association.GetValue()
+			if !v.nullables_.ContainsValue(name) && v.isNullableDefinition(definition) {
+				v.nullables_.AddValue(name)
+				found = true
+			}
+		}
+		var patterns = v.patterns_.GetIterator()
+		for patterns.HasNext() {
+			var association = patterns.GetNext()
+			var name = association.GetKey()
+			var pattern = association.GetValue()
+			if !v.nullables_.ContainsValue(name) && v.isNullablePattern(pattern) {
+				v.nullables_.AddValue(name)
+				found = true
+			}
+		}
+	}
+}
+
+func (v *analyzer_) findShadowing(
+	first *syn.Prog,
+	second *syn.Prog,
+) (
+	prefix string,
+	example string,
+	ok bool,
+) {
+	// Search both programs in lock step for a string matched by the second
+	// program that has a non-empty prefix matched by the first program.
+	type state struct {
+		first  uint32
+		second uint32
+	}
+	var visited = map[state]bool{}
+	var witnesses = map[state][]rune{}
+	var start = state{uint32(first.Start), uint32(second.Start)}
+	var queue = []state{start}
+	visited[start] = true
+	for len(queue) > 0 {
+		var current = queue[0]
+		queue = queue[1:]
+		var witness = witnesses[current]
+		var seconds = v.closeProgram(second, current.second)
+		for _, pc1 := range v.closeProgram(first, current.first) {
+			var instruction1 = &first.Inst[pc1]
+			if instruction1.Op == syn.InstMatch {
+				if len(witness) > 0 {
+					var suffix, ok = v.findMatch(second, seconds)
+					if ok {
+						prefix = string(witness)
+						example = prefix + string(suffix)
+						return prefix, example, ok
+					}
+				}
+				continue
+			}
+			for _, pc2 := range seconds {
+				var instruction2 = &second.Inst[pc2]
+				if instruction2.Op == syn.InstMatch {
+					continue
+				}
+				var character, ok = v.findCharacter(instruction1, instruction2)
+				if !ok {
+					continue
+				}
+				var next = state{instruction1.Out, instruction2.Out}
+				if visited[next] {
+					continue
+				}
+				visited[next] = true
+				witnesses[next] = append(append([]rune{}, witness...), character)
+				queue = append(queue, next)
+			}
+		}
+	}
+	return prefix, example, ok
+}
+
+func (v *analyzer_) getFirst(name string) abs.Sequential[string] {
+	if gra.Scanner().MatchesType(name, gra.UppercaseToken) {
+		var first = v.firsts_.GetValue(name)
+		if col.IsDefined(first) {
+			return first
+		}
+	}
+	// A token is its own first set.
+	return col.List[string]([]string{name})
+}
+
+func (v *analyzer_) getFirstOfTerms(
+	terms []ast.TermLike,
+) (
+	first abs.SetLike[string],
+	isNullable bool,
+) {
+	first = col.Set[string]()
+	for _, term := range terms {
+		switch actual := term.GetAny().(type) {
+		case string:
+			// A literal always matches a delimiter token.
+			first.AddValue(actual)
+			return first, false
+		case ast.LookaheadLike:
+			// A lookahead never consumes a token.
+			continue
+		case ast.ReferenceLike:
+			var name = actual.GetIdentifier().GetAny().(string)
+			first.AddValues(col.List[string](v.getFirst(name).AsArray()))
+			if v.requiresSeparator(actual) && v.isNullableIdentifier(name) {
+				// The separator follows an instance that matches nothing.
+				first.AddValue(actual.GetOptionalSeparator().GetLiteral())
+			}
+			if !v.isNullableTerm(term) {
+				return first, false
+			}
+		}
+	}
+	return first, true
+}
+
+func (v *analyzer_) getOperators(
+	precedence ast.PrecedenceLike,
+) abs.Sequential[string] {
+	var operators = col.List[string]()
+	var levels = precedence.GetLevels().GetIterator()
+	for levels.HasNext() {
+		var iterator = levels.GetNext().GetOperators().GetIterator()
+		for iterator.HasNext() {
+			operators.AppendValue(iterator.GetNext().GetLiteral())
+		}
+	}
+	return operators
+}
+
+func (v *analyzer_) getRanges(instruction *syn.Inst) []rune {
+	// Return the characters matched by the instruction as low, high pairs.
+	switch instruction.Op {
+	case syn.InstRune1:
+		return []rune{instruction.Rune[0], instruction.Rune[0]}
+	case syn.InstRuneAny:
+		return []rune{0, uni.MaxRune}
+	case syn.InstRuneAnyNotNL:
+		return []rune{0, '\n' - 1, '\n' + 1, uni.MaxRune}
+	case syn.InstRune:
+		if len(instruction.Rune) == 1 {
+			// Include every case of a single character.
+			var ranges []rune
+			var character = instruction.Rune[0]
+			var folded = character
+			for {
+				ranges = append(ranges, folded, folded)
+				if syn.Flags(instruction.Arg)&syn.FoldCase == 0 {
+					break
+				}
+				folded = uni.SimpleFold(folded)
+				if folded == character {
+					break
+				}
+			}
+			return ranges
+		}
+		return instruction.Rune
+	}
+	return nil
+}
+
+func (v *analyzer_) isNullableDefinition(definition ast.DefinitionLike) bool {
+	switch actual := definition.GetAny().(type) {
+	case ast.MultilineLike:
+		// Any nullable alternative makes the rule nullable.
+		var lines = actual.GetLines().GetIterator()
+		for lines.HasNext() {
+			var identifier = lines.GetNext().GetIdentifier().GetAny().(string)
+			if v.isNullableIdentifier(identifier) {
+				return true
+			}
+		}
+		return false
+	case ast.PrecedenceLike:
+		// Every binary operation contains an operator so only the operand may
+		// match nothing.
+		var identifier = actual.GetIdentifier().GetAny().(string)
+		return v.isNullableIdentifier(identifier)
+	case ast.InlineLike:
+		// Every term must be nullable for the rule to be nullable.
+		var terms = actual.GetTerms().GetIterator()
+		for terms.HasNext() {
+			if !v.isNullableTerm(terms.GetNext()) {
+				return false
+			}
+		}
+		return true
+	}
+	return false
+}
+
+func (v *analyzer_) isNullableElement(element ast.ElementLike) bool {
+	switch actual := element.GetAny().(type) {
+	case ast.GroupLike:
+		return v.isNullablePattern(actual.GetPattern())
+	case ast.TextLike:
+		// Only a reference to a nullable expression may match nothing.
+		var text = actual.GetAny().(string)
+		return v.nullables_.ContainsValue(text)
+	}
+	// A filter always matches a single character.
+	return false
+}
+
+func (v *analyzer_) isNullableIdentifier(identifier string) bool {
+	// The scanner never produces an empty token so only rules may be nullable.
+	return gra.Scanner().MatchesType(identifier, gra.UppercaseToken) &&
+		v.nullables_.ContainsValue(identifier)
+}
+
+func (v *analyzer_) isNullableOption(option ast.OptionLike) bool {
+	// Every repetition must be nullable for the option to be nullable.
+	var repetitions = option.GetRepetitions().GetIterator()
+	for repetitions.HasNext() {
+		var repetition = repetitions.GetNext()
+		if !v.allowsNone(repetition.GetOptionalCardinality()) &&
+			!v.isNullableElement(repetition.GetElement()) {
+			return false
+		}
+	}
+	return true
+}
+
+func (v *analyzer_) isNullablePattern(pattern ast.PatternLike) bool {
+	// Any nullable option makes the pattern nullable.
+	if v.isNullableOption(pattern.GetOption()) {
+		return true
+	}
+	var alternatives = pattern.GetAlternatives().GetIterator()
+	for alternatives.HasNext() {
+		var alternative = alternatives.GetNext()
+		if v.isNullableOption(alternative.GetOption()) {
+			return true
+		}
+	}
+	return false
+}
+
+func (v *analyzer_) isNullableTerm(term ast.TermLike) bool {
+	switch actual := term.GetAny().(type) {
+	case ast.LookaheadLike:
+		// A lookahead never consumes a token.
+		return true
+	case ast.ReferenceLike:
+		if v.requiresSeparator(actual) {
+			// A separator always consumes a token.
+			return false
+		}
+		if v.allowsNone(actual.GetOptionalCardinality()) {
+			return true
+		}
+		var identifier = actual.GetIdentifier().GetAny().(string)
+		return v.isNullableIdentifier(identifier)
+	}
+	// A literal always consumes a token.
+	return false
+}
+
+func (v *analyzer_) requiresSeparator(reference ast.ReferenceLike) bool {
+	// A separator appears between the instances whenever at least two of them
+	// are required.
+	var cardinality = reference.GetOptionalCardinality()
+	if col.IsUndefined(reference.GetOptionalSeparator()) || col.IsUndefined(cardinality) {
+		return false
+	}
+	var quantified, ok = cardinality.GetAny().(ast.QuantifiedLike)
+	if !ok {
+		return false
+	}
+	var minimum, _ = stc.Atoi(quantified.GetNumber())
+	return minimum > 1
+}
+
+func (v *analyzer_) resolveExpression(name string) string {
+	var expression, ok = intrinsics_[name]
+	if ok {
+		return expression
+	}
+
+	// The regular expression is a concatenation of Go strings and constants.
+	var resolved string
+	var remaining = v.regexps_.GetValue(name)
+	for len(remaining) > 0 {
+		switch {
+		case sts.HasPrefix(remaining, " + "):
+			remaining = remaining[3:]
+		case sts.HasPrefix(remaining, `"`):
+			var end = 1
+			for remaining[end] != '"' {
+				if remaining[end] == '\\' {
+					end++ // Skip the escaped character.
+				}
+				end++
+			}
+			var text, err = stc.Unquote(remaining[:end+1])
+			if err != nil {
+				panic(err)
+			}
+			resolved += text
+			remaining = remaining[end+1:]
+		default:
+			var end = sts.Index(remaining, "_")
+			resolved += v.resolveExpression(remaining[:end])
+			remaining = remaining[end+1:]
+		}
+	}
+	return resolved
+}
+
+// PRIVATE GLOBALS
+
+// Constants
+
+var intrinsics_ = map[string]string{
+	// These must match the intrinsic patterns defined by the generated scanner.
+	"any":     ".",
+	"control": "\\p{Cc}",
+	"digit":   "\\p{Nd}",
+	"eol":     "\\r?\\n",
+	"letter":  "\\p{L}",
+	"lower":   "\\p{Ll}",
+	"punct":   "\\p{P}",
+	"symbol":  "\\p{S}",
+	"upper":   "\\p{Lu}",
+	"white":   "\\t-\\r\\x85\\p{Z}",
+}
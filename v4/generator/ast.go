@@ -43,11 +43,16 @@ type astClass_ struct {
 // Constructors
 
 func (c *astClass_) Make() AstLike {
+	return c.MakeWithTemplates(Templates().Make())
+}
+
+func (c *astClass_) MakeWithTemplates(templates TemplatesLike) AstLike {
 	var ast = &ast_{
 		// Initialize the instance attributes.
-		class_:    c,
-		analyzer_: Analyzer().Make(),
-		modules_:  col.Catalog[string, string](),
+		class_:     c,
+		analyzer_:  Analyzer().Make(),
+		modules_:   col.Catalog[string, string](),
+		templates_: templates,
 	}
 	return ast
 }
@@ -58,9 +63,10 @@ func (c *astClass_) Make() AstLike {
 
 type ast_ struct {
 	// Define the instance attributes.
-	class_    *astClass_
-	analyzer_ AnalyzerLike
-	modules_  abs.CatalogLike[string, string]
+	class_     *astClass_
+	analyzer_  AnalyzerLike
+	modules_   abs.CatalogLike[string, string]
+	templates_ TemplatesLike
 }
 
 // Public
@@ -87,6 +93,7 @@ func (v *ast_) GenerateAstModel(
 	implementation = replaceAll(implementation, "classes", classes)
 	var instances = v.generateInstances()
 	implementation = replaceAll(implementation, "instances", instances)
+	implementation = removeUnusedImports(implementation)
 	return implementation
 }
 
@@ -122,25 +129,40 @@ func (v *ast_) generateClass(
 ) {
 	var parameters string
 	var attributes = v.analyzer_.GetReferences(className)
-	if col.IsDefined(attributes) {
+	var precedenceRule = v.analyzer_.GetPrecedenceRule(className)
+	switch {
+	case col.IsDefined(attributes):
 		// This class represents an inline rule.
 		var references = attributes.GetIterator()
+		var variableNames = generateVariableNames(attributes).GetIterator()
 		for references.HasNext() {
 			var reference = references.GetNext()
 			var isPlural = v.isPlural(reference)
-			var attributeName = generateVariableName(reference)
+			var attributeName = variableNames.GetNext()
 			var attributeType = generateVariableType(reference)
 			parameters += v.generateParameter(isPlural, attributeName, attributeType)
 		}
 		if attributes.GetSize() > 0 {
 			parameters += "\n\t"
 		}
-	} else {
+	case col.IsDefined(precedenceRule):
+		// This class represents a binary operation from a precedence table.
+		var operandType = makeUpperCase(precedenceRule) + "Like"
+		parameters += v.generateParameter(false, "left", operandType)
+		parameters += v.generateParameter(false, "operator", "string")
+		parameters += v.generateParameter(false, "right", operandType)
+		parameters += "\n\t"
+	default:
 		// This class represents a multiline rule.
 		parameters += "\n\t\tany_ any,\n\t"
 	}
+	var spanParameters = parameters
+	if len(spanParameters) == 0 {
+		spanParameters = "\n\t"
+	}
 	class = v.getTemplate(classDeclaration)
 	class = replaceAll(class, "parameters", parameters)
+	class = replaceAll(class, "spanParameters", spanParameters)
 	class = replaceAll(class, "className", className)
 	return class
 }
@@ -221,27 +243,33 @@ func (v *ast_) generateInstance(
 ) {
 	var getters string
 	var attributes = v.analyzer_.GetReferences(className)
-	if col.IsDefined(attributes) {
+	var precedenceRule = v.analyzer_.GetPrecedenceRule(className)
+	switch {
+	case col.IsDefined(attributes):
 		// This instance represents an inline rule.
 		var references = attributes.GetIterator()
+		var variableNames = generateVariableNames(attributes).GetIterator()
 		for references.HasNext() {
 			var reference = references.GetNext()
 			var isPlural = v.isPlural(reference)
-			var attributeName = generateVariableName(reference)
+			var attributeName = variableNames.GetNext()
 			var attributeType = generateVariableType(reference)
 			getters += v.generateGetter(isPlural, attributeName, attributeType)
 		}
-	} else {
+	case col.IsDefined(precedenceRule):
+		// This instance represents a binary operation from a precedence table.
+		var operandType = makeUpperCase(precedenceRule) + "Like"
+		getters += v.generateGetter(false, "left", operandType)
+		getters += v.generateGetter(false, "operator", "string")
+		getters += v.generateGetter(false, "right", operandType)
+	default:
 		// This instance represents a multiline rule.
 		getters += "\n\tGetAny() any"
 	}
 	instance = v.getTemplate(instanceDeclaration)
 	instance = replaceAll(instance, "publicMethods", v.getTemplate(publicMethods))
-	var template string
-	if col.IsDefined(getters) {
-		template = v.getTemplate(attributeMethods)
-		template = replaceAll(template, "getters", getters)
-	}
+	var template = v.getTemplate(attributeMethods)
+	template = replaceAll(template, "getters", getters)
 	instance = replaceAll(instance, "attributeMethods", template)
 	instance = replaceAll(instance, "className", className)
 	return instance
@@ -264,7 +292,7 @@ func (v *ast_) generateNotice() string {
 }
 
 func (v *ast_) getTemplate(name string) string {
-	var template = astTemplates_.GetValue(name)
+	var template = v.templates_.GetTemplate("ast/" + name)
 	return template
 }
 
@@ -329,8 +357,10 @@ class constants, constructors and functions that must be supported by each
 concrete <class-name>-like class.
 */
 type <ClassName>ClassLike interface {
-	// Constructor
+	// Constructors
 	Make(<parameters>) <ClassName>Like
+	MakeWithSpan(
+		span SpanLike,<spanParameters>) <ClassName>Like
 }
 `,
 		singularRuleParameter: `
@@ -355,6 +385,7 @@ type <ClassName>Like interface {<PublicMethods><AttributeMethods>}
 `,
 		attributeMethods: `
 	// Attribute<Getters>
+	GetSpan() SpanLike
 `,
 		ruleGetterMethod: `
 	Get<AttributeName>() <AttributeType>`,
@@ -375,7 +406,72 @@ import (
 
 // Classes
 <Classes>
+/*
+PositionClassLike is a class interface that defines the complete set of
+class constants, constructors and functions that must be supported by each
+concrete position-like class.
+*/
+type PositionClassLike interface {
+	// Constructor
+	Make(
+		line uint,
+		column uint,
+		offset uint,
+	) PositionLike
+}
+
+/*
+SpanClassLike is a class interface that defines the complete set of
+class constants, constructors and functions that must be supported by each
+concrete span-like class.
+*/
+type SpanClassLike interface {
+	// Constructors
+	Make(
+		start PositionLike,
+		end PositionLike,
+	) SpanLike
+	MakeWithSource(
+		source string,
+		start PositionLike,
+		end PositionLike,
+	) SpanLike
+}
+
 // Instances
-<Instances>`,
+<Instances>
+/*
+PositionLike is an instance interface that defines the complete set of
+instance attributes, abstractions and methods that must be supported by each
+instance of a concrete position-like class.  Lines and columns are one based
+while the offset is a zero based index of a byte in the source.
+*/
+type PositionLike interface {
+	// Public
+	GetClass() PositionClassLike
+
+	// Attribute
+	GetLine() uint
+	GetColumn() uint
+	GetOffset() uint
+}
+
+/*
+SpanLike is an instance interface that defines the complete set of instance
+attributes, abstractions and methods that must be supported by each instance of
+a concrete span-like class.  The end position follows the last character in the
+span.  The source names the file containing the span when it is not the source
+that was parsed, e.g. a file that it imports.
+*/
+type SpanLike interface {
+	// Public
+	GetClass() SpanClassLike
+
+	// Attribute
+	GetSource() string
+	GetStart() PositionLike
+	GetEnd() PositionLike
+}
+`,
 	},
 )
@@ -42,10 +42,15 @@ type formatterClass_ struct {
 // Constructors
 
 func (c *formatterClass_) Make() FormatterLike {
+	return c.MakeWithTemplates(Templates().Make())
+}
+
+func (c *formatterClass_) MakeWithTemplates(templates TemplatesLike) FormatterLike {
 	var formatter = &formatter_{
 		// Initialize the instance attributes.
-		class_:    c,
-		analyzer_: Analyzer().Make(),
+		class_:     c,
+		analyzer_:  Analyzer().Make(),
+		templates_: templates,
 	}
 	return formatter
 }
@@ -56,8 +61,9 @@ func (c *formatterClass_) Make() FormatterLike {
 
 type formatter_ struct {
 	// Define the instance attributes.
-	class_    *formatterClass_
-	analyzer_ AnalyzerLike
+	class_     *formatterClass_
+	analyzer_  AnalyzerLike
+	templates_ TemplatesLike
 }
 
 // Public
@@ -83,6 +89,7 @@ func (v *formatter_) GenerateFormatterClass(
 	implementation = replaceAll(implementation, "ruleFormatters", ruleFormatters)
 	var name = v.analyzer_.GetSyntaxName()
 	implementation = replaceAll(implementation, "name", name)
+	implementation = removeUnusedImports(implementation)
 	return implementation
 }
 
@@ -111,7 +118,11 @@ func (v *formatter_) generateTokenFormatters() string {
 	var iterator = v.analyzer_.GetTokenNames().GetIterator()
 	for iterator.HasNext() {
 		var tokenName = iterator.GetNext()
-		if tokenName == "delimiter" {
+		switch tokenName {
+		case "delimiter", "keyword":
+			continue
+		case "dedent", "indent":
+			// The canonical values of the indentation tokens are not formatted.
 			continue
 		}
 		var isPlural = v.analyzer_.IsPlural(tokenName)
@@ -128,7 +139,7 @@ func (v *formatter_) generateTokenFormatters() string {
 }
 
 func (v *formatter_) getTemplate(name string) string {
-	var template = formatterTemplates_.GetValue(name)
+	var template = v.templates_.GetTemplate("formatter/" + name)
 	return template
 }
 
@@ -42,10 +42,15 @@ type grammarClass_ struct {
 // Constructors
 
 func (c *grammarClass_) Make() GrammarLike {
+	return c.MakeWithTemplates(Templates().Make())
+}
+
+func (c *grammarClass_) MakeWithTemplates(templates TemplatesLike) GrammarLike {
 	var grammar = &grammar_{
 		// Initialize the instance attributes.
-		class_:    c,
-		analyzer_: Analyzer().Make(),
+		class_:     c,
+		analyzer_:  Analyzer().Make(),
+		templates_: templates,
 	}
 	return grammar
 }
@@ -56,8 +61,9 @@ func (c *grammarClass_) Make() GrammarLike {
 
 type grammar_ struct {
 	// Define the instance attributes.
-	class_    *grammarClass_
-	analyzer_ AnalyzerLike
+	class_     *grammarClass_
+	analyzer_  AnalyzerLike
+	templates_ TemplatesLike
 }
 
 // Public
@@ -84,12 +90,13 @@ func (v *grammar_) GenerateGrammarModel(
 	var name = v.analyzer_.GetSyntaxName()
 	implementation = replaceAll(implementation, "name", name)
 	implementation = replaceAll(implementation, "parameter", name)
-	var tokenTypes = v.generateTokenTypes()
+	var tokenTypes = v.generateTokenTypes(syntax)
 	implementation = replaceAll(implementation, "tokenTypes", tokenTypes)
 	var processTokens = v.generateProcessTokens()
 	implementation = replaceAll(implementation, "processTokens", processTokens)
 	var processRules = v.generateProcessRules()
 	implementation = replaceAll(implementation, "processRules", processRules)
+	implementation = removeUnusedImports(implementation)
 	return implementation
 }
 
@@ -125,7 +132,7 @@ func (v *grammar_) generateProcessTokens() string {
 	var iterator = v.analyzer_.GetTokenNames().GetIterator()
 	for iterator.HasNext() {
 		var name = iterator.GetNext()
-		if name == "delimiter" {
+		if name == "delimiter" || name == "keyword" {
 			continue
 		}
 		var parameters = "(\n\t\t"
@@ -144,19 +151,55 @@ func (v *grammar_) generateProcessTokens() string {
 	return processTokens
 }
 
-func (v *grammar_) generateTokenTypes() string {
-	var tokenTypes = "ErrorToken TokenType = iota"
-	var iterator = v.analyzer_.GetTokenNames().GetIterator()
+func (v *grammar_) generateTokenTypes(syntax ast.SyntaxLike) string {
+	// The token types are enumerated in the order that their expressions are
+	// declared, so appending an expression to a syntax does not change the
+	// values of its existing token types.  Each token type that is not declared
+	// precedes the first declared one that follows it alphabetically, except
+	// for the keyword token type which was introduced last.
+	var tokenNames = col.Set[string](v.analyzer_.GetTokenNames())
+	var declared = col.Set[string]()
+	var names []string
+	var expressions = col.List[ast.ExpressionLike](syntax.GetExpressions())
+	var modes = syntax.GetModes().GetIterator()
+	for modes.HasNext() {
+		expressions.AppendValues(modes.GetNext().GetExpressions())
+	}
+	var iterator = expressions.GetIterator()
 	for iterator.HasNext() {
-		var name = iterator.GetNext()
-		var tokenType = makeUpperCase(name) + "Token"
-		tokenTypes += "\n\t" + tokenType
+		var name = iterator.GetNext().GetLowercase()
+		if tokenNames.ContainsValue(name) && !declared.ContainsValue(name) {
+			declared.AddValue(name)
+			names = append(names, name)
+		}
+	}
+	var undeclared []string
+	var tokens = tokenNames.GetIterator()
+	for tokens.HasNext() {
+		var name = tokens.GetNext()
+		if name != "keyword" && !declared.ContainsValue(name) {
+			undeclared = append(undeclared, name)
+		}
+	}
+	var tokenTypes = "ErrorToken TokenType = iota"
+	for _, name := range names {
+		for len(undeclared) > 0 && undeclared[0] < name {
+			tokenTypes += "\n\t" + makeUpperCase(undeclared[0]) + "Token"
+			undeclared = undeclared[1:]
+		}
+		tokenTypes += "\n\t" + makeUpperCase(name) + "Token"
+	}
+	for _, name := range undeclared {
+		tokenTypes += "\n\t" + makeUpperCase(name) + "Token"
+	}
+	if tokenNames.ContainsValue("keyword") {
+		tokenTypes += "\n\tKeywordToken"
 	}
 	return tokenTypes
 }
 
 func (v *grammar_) getTemplate(name string) string {
-	var template = grammarTemplates_.GetValue(name)
+	var template = v.templates_.GetTemplate("grammar/" + name)
 	return template
 }
 
@@ -173,6 +216,7 @@ abstract syntax tree (AST) for this module:
   - Token captures the attributes associated with a parsed token.
   - Scanner is used to scan the source byte stream and recognize matching tokens.
   - Parser is used to process the token stream and generate the AST.
+  - ParseError captures the attributes associated with a syntax error.
   - Validator is used to validate the semantics associated with an AST.
   - Formatter is used to format an AST back into a canonical version of its source.
   - Visitor walks the AST and calls processor methods for each node in the tree.
@@ -233,6 +277,23 @@ type ParserClassLike interface {
 	Make() ParserLike
 }
 
+/*
+ParseErrorClassLike is a class interface that defines the complete set of
+class constants, constructors and functions that must be supported by each
+concrete parse-error-like class.
+*/
+type ParseErrorClassLike interface {
+	// Constructor
+	Make(
+		line uint,
+		position uint,
+		token TokenLike,
+		ruleName string,
+		expected string,
+		message string,
+	) ParseErrorLike
+}
+
 /*
 ProcessorClassLike is a class interface that defines the complete set of
 class constants, constructors and functions that must be supported by each
@@ -280,13 +341,20 @@ class constants, constructors and functions that must be supported by each
 concrete token-like class.
 */
 type TokenClassLike interface {
-	// Constructor
+	// Constructors
 	Make(
 		line uint,
 		position uint,
 		type_ TokenType,
 		value string,
 	) TokenLike
+	MakeWithOffset(
+		line uint,
+		position uint,
+		offset uint,
+		type_ TokenType,
+		value string,
+	) TokenLike
 }
 
 /*
@@ -340,6 +408,33 @@ type ParserLike interface {
 	ParseSource(
 		source string,
 	) ast.<Name>Like
+	ParseSourceWithErrors(
+		source string,
+	) (
+		<parameter> ast.<Name>Like,
+		errors abs.Sequential[ParseErrorLike],
+	)
+}
+
+/*
+ParseErrorLike is an instance interface that defines the complete set of
+instance attributes, abstractions and methods that must be supported by each
+instance of a concrete parse-error-like class.  The token attribute is nil if
+the error occurred at the end of the source.  The expected attribute contains
+the definition of the named rule that was being parsed.
+*/
+type ParseErrorLike interface {
+	// Public
+	GetClass() ParseErrorClassLike
+	Error() string
+
+	// Attribute
+	GetLine() uint
+	GetPosition() uint
+	GetToken() TokenLike
+	GetRuleName() string
+	GetExpected() string
+	GetMessage() string
 }
 
 /*
@@ -363,6 +458,7 @@ instance of a concrete scanner-like class.
 type ScannerLike interface {
 	// Public
 	GetClass() ScannerClassLike
+	Close()
 }
 
 /*
@@ -377,6 +473,7 @@ type TokenLike interface {
 	// Attribute
 	GetLine() uint
 	GetPosition() uint
+	GetOffset() uint
 	GetType() TokenType
 	GetValue() string
 }
@@ -0,0 +1,311 @@
+/*
+................................................................................
+.    Copyright (c) 2009-2024 Crater Dog Technologies.  All Rights Reserved.    .
+................................................................................
+.  DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               .
+.                                                                              .
+.  This code is free software; you can redistribute it and/or modify it under  .
+.  the terms of The MIT License (MIT), as published by the Open Source         .
+.  Initiative. (See https://opensource.org/license/MIT)                        .
+................................................................................
+*/
+
+package generator
+
+import (
+	fmt "fmt"
+	goa "go/ast"
+	gof "go/format"
+	gop "go/parser"
+	got "go/token"
+	sts "strings"
+)
+
+// CLASS ACCESS
+
+// Reference
+
+var mergerClass = &mergerClass_{
+	// Initialize the class constants.
+}
+
+// Function
+
+func Merger() MergerClassLike {
+	return mergerClass
+}
+
+// CLASS METHODS
+
+// Target
+
+type mergerClass_ struct {
+	// Define the class constants.
+}
+
+// Constructors
+
+func (c *mergerClass_) Make() MergerLike {
+	return &merger_{
+		// Initialize the instance attributes.
+		class_: c,
+	}
+}
+
+// INSTANCE METHODS
+
+// Target
+
+type merger_ struct {
+	// Define the instance attributes.
+	class_ *mergerClass_
+	files_ *got.FileSet
+}
+
+// Public
+
+func (v *merger_) GetClass() MergerClassLike {
+	return v.class_
+}
+
+func (v *merger_) MergeSource(
+	existing string,
+	generated string,
+) (
+	merged string,
+) {
+	v.files_ = got.NewFileSet()
+	var current = v.parseSource("existing", existing)
+	var skeleton = v.parseSource("generated", generated)
+	var keys = map[string]bool{}
+	for _, declaration := range skeleton.Decls {
+		keys[v.getKey(declaration)] = true
+	}
+
+	// Collect the preserved declarations from the existing source.  Those that
+	// are not part of the generated skeleton follow the declaration that they
+	// followed in the existing source.
+	var preserved = map[string]string{}
+	var additional = map[string][]string{}
+	var texts []string
+	var anchor string
+	for _, declaration := range current.Decls {
+		var key = v.getKey(declaration)
+		if v.isPreserved(declaration) {
+			var text = v.getText(existing, declaration)
+			texts = append(texts, text)
+			if keys[key] {
+				preserved[key] = text
+			} else {
+				additional[anchor] = append(additional[anchor], text)
+			}
+		}
+		if keys[key] {
+			anchor = key
+		}
+	}
+	if len(texts) == 0 {
+		// There is nothing to preserve.
+		return generated
+	}
+
+	// Rebuild the generated skeleton around the preserved declarations.
+	var builder sts.Builder
+	var offset = 0
+	for _, declaration := range skeleton.Decls {
+		var key = v.getKey(declaration)
+		var start, end = v.getRange(declaration)
+		builder.WriteString(generated[offset:start])
+		var text, ok = preserved[key]
+		if !ok {
+			text = generated[start:end]
+		}
+		if key == importKey_ {
+			text = v.mergeImports(existing, current, skeleton, text, texts)
+		}
+		builder.WriteString(text)
+		for _, text = range additional[key] {
+			builder.WriteString("\n\n" + text)
+		}
+		offset = end
+	}
+	builder.WriteString(generated[offset:])
+
+	// Any declarations preceding all generated ones are appended instead.
+	for _, text := range additional[""] {
+		builder.WriteString("\n" + text + "\n")
+	}
+	merged = v.formatSource(builder.String())
+	return merged
+}
+
+// Private
+
+func (v *merger_) formatSource(source string) string {
+	var bytes, err = gof.Source([]byte(source))
+	if err != nil {
+		var message = fmt.Sprintf(
+			"The merged source could not be formatted: %v",
+			err,
+		)
+		panic(message)
+	}
+	return string(bytes)
+}
+
+func (v *merger_) getKey(declaration goa.Decl) string {
+	switch actual := declaration.(type) {
+	case *goa.FuncDecl:
+		if actual.Recv == nil || len(actual.Recv.List) == 0 {
+			return "func " + actual.Name.Name
+		}
+		var receiver = actual.Recv.List[0].Type
+		if star, ok := receiver.(*goa.StarExpr); ok {
+			receiver = star.X
+		}
+		if index, ok := receiver.(*goa.IndexExpr); ok {
+			receiver = index.X
+		}
+		if ident, ok := receiver.(*goa.Ident); ok {
+			return "func (" + ident.Name + ") " + actual.Name.Name
+		}
+		return "func " + actual.Name.Name
+	case *goa.GenDecl:
+		if actual.Tok == got.IMPORT {
+			return importKey_
+		}
+		var names []string
+		for _, specification := range actual.Specs {
+			switch spec := specification.(type) {
+			case *goa.TypeSpec:
+				names = append(names, spec.Name.Name)
+			case *goa.ValueSpec:
+				for _, name := range spec.Names {
+					names = append(names, name.Name)
+				}
+			}
+		}
+		return actual.Tok.String() + " " + sts.Join(names, ", ")
+	default:
+		return ""
+	}
+}
+
+func (v *merger_) getPackages(texts []string) map[string]bool {
+	// Any package referenced by a preserved declaration must remain imported.
+	var packages = map[string]bool{}
+	for _, text := range texts {
+		var file, err = gop.ParseFile(got.NewFileSet(), "", "package p\n"+text, 0)
+		if err != nil {
+			continue
+		}
+		goa.Inspect(file, func(node goa.Node) bool {
+			if selector, ok := node.(*goa.SelectorExpr); ok {
+				if ident, ok := selector.X.(*goa.Ident); ok {
+					packages[ident.Name] = true
+				}
+			}
+			return true
+		})
+	}
+	return packages
+}
+
+func (v *merger_) getRange(node goa.Node) (start int, end int) {
+	var first = node.Pos()
+	var doc *goa.CommentGroup
+	switch actual := node.(type) {
+	case *goa.FuncDecl:
+		doc = actual.Doc
+	case *goa.GenDecl:
+		doc = actual.Doc
+	case *goa.ImportSpec:
+		doc = actual.Doc
+	}
+	if doc != nil {
+		first = doc.Pos()
+	}
+	start = v.files_.Position(first).Offset
+	end = v.files_.Position(node.End()).Offset
+	return start, end
+}
+
+func (v *merger_) getText(source string, node goa.Node) string {
+	var start, end = v.getRange(node)
+	return source[start:end]
+}
+
+func (v *merger_) isPreserved(declaration goa.Decl) bool {
+	var doc *goa.CommentGroup
+	switch actual := declaration.(type) {
+	case *goa.FuncDecl:
+		doc = actual.Doc
+	case *goa.GenDecl:
+		doc = actual.Doc
+	}
+	if doc == nil {
+		return false
+	}
+	for _, comment := range doc.List {
+		if comment.Text == preserveDirective_ {
+			return true
+		}
+	}
+	return false
+}
+
+func (v *merger_) mergeImports(
+	existing string,
+	current *goa.File,
+	skeleton *goa.File,
+	text string,
+	texts []string,
+) string {
+	var packages = v.getPackages(texts)
+	var imported = map[string]bool{}
+	for _, specification := range skeleton.Imports {
+		imported[specification.Path.Value] = true
+	}
+	var missing []string
+	for _, specification := range current.Imports {
+		var path = sts.Trim(specification.Path.Value, `"`)
+		var name = path[sts.LastIndex(path, "/")+1:]
+		if specification.Name != nil {
+			name = specification.Name.Name
+		}
+		if packages[name] && !imported[specification.Path.Value] {
+			missing = append(missing, v.getText(existing, specification))
+		}
+	}
+	if len(missing) == 0 {
+		return text
+	}
+	if !sts.HasSuffix(text, ")") {
+		// The generated skeleton contains a single unparenthesized import.
+		return text + "\n\nimport (\n\t" + sts.Join(missing, "\n\t") + "\n)"
+	}
+	var index = len(text) - 1
+	return text[:index] + "\t" + sts.Join(missing, "\n\t") + "\n" + text[index:]
+}
+
+func (v *merger_) parseSource(name string, source string) *goa.File {
+	var file, err = gop.ParseFile(v.files_, name, source, gop.ParseComments)
+	if err != nil {
+		var message = fmt.Sprintf(
+			"The %v source could not be parsed: %v",
+			name,
+			err,
+		)
+		panic(message)
+	}
+	return file
+}
+
+// PRIVATE GLOBALS
+
+// Constants
+
+const (
+	importKey_         = "import"
+	preserveDirective_ = "//cdsn:preserve"
+)
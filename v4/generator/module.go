@@ -0,0 +1,373 @@
+/*
+................................................................................
+.    Copyright (c) 2009-2024 Crater Dog Technologies.  All Rights Reserved.    .
+................................................................................
+.  DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               .
+.                                                                              .
+.  This code is free software; you can redistribute it and/or modify it under  .
+.  the terms of The MIT License (MIT), as published by the Open Source         .
+.  Initiative. (See https://opensource.org/license/MIT)                        .
+................................................................................
+*/
+
+package generator
+
+import (
+	fmt "fmt"
+	col "github.com/craterdog/go-collection-framework/v4"
+	abs "github.com/craterdog/go-collection-framework/v4/collection"
+	ast "github.com/craterdog/go-grammar-framework/v4/ast"
+	gof "go/format"
+	osx "os"
+	fil "path/filepath"
+	sts "strings"
+)
+
+// CLASS ACCESS
+
+// Reference
+
+var moduleClass = &moduleClass_{
+	// Initialize the class constants.
+}
+
+// Function
+
+func Module() ModuleClassLike {
+	return moduleClass
+}
+
+// CLASS METHODS
+
+// Target
+
+type moduleClass_ struct {
+	// Define the class constants.
+}
+
+// Constructors
+
+func (c *moduleClass_) Make() ModuleLike {
+	return c.MakeWithTemplates(Templates().Make())
+}
+
+func (c *moduleClass_) MakeWithTemplates(templates TemplatesLike) ModuleLike {
+	return &module_{
+		// Initialize the instance attributes.
+		class_:     c,
+		analyzer_:  Analyzer().Make(),
+		merger_:    Merger().Make(),
+		templates_: templates,
+	}
+}
+
+// INSTANCE METHODS
+
+// Target
+
+type module_ struct {
+	// Define the instance attributes.
+	class_     *moduleClass_
+	analyzer_  AnalyzerLike
+	merger_    MergerLike
+	templates_ TemplatesLike
+}
+
+// Public
+
+func (v *module_) GetClass() ModuleClassLike {
+	return v.class_
+}
+
+func (v *module_) CheckModule(
+	directory string,
+	module string,
+	wiki string,
+	syntax ast.SyntaxLike,
+) (
+	differences abs.CatalogLike[string, string],
+) {
+	differences = col.Catalog[string, string]()
+	var files = v.generateFiles(module, wiki, syntax)
+	var iterator = files.GetIterator()
+	for iterator.HasNext() {
+		var association = iterator.GetNext()
+		var path = fil.Join(directory, association.GetKey())
+		var generated = v.formatSource(path, association.GetValue())
+		var current, exists = v.readFile(path)
+		if exists {
+			generated = v.merger_.MergeSource(current, generated)
+		}
+		if current != generated {
+			var difference = v.generateDifference(path, exists, current, generated)
+			differences.SetValue(path, difference)
+		}
+	}
+	return differences
+}
+
+func (v *module_) GenerateModule(
+	directory string,
+	module string,
+	wiki string,
+	syntax ast.SyntaxLike,
+) (
+	changed abs.Sequential[string],
+) {
+	var files = v.generateFiles(module, wiki, syntax)
+	var paths = col.List[string]()
+	var iterator = files.GetIterator()
+	for iterator.HasNext() {
+		var association = iterator.GetNext()
+		var path = fil.Join(directory, association.GetKey())
+		var source = v.formatSource(path, association.GetValue())
+		if v.writeFile(path, source) {
+			paths.AppendValue(path)
+		}
+	}
+	changed = paths
+	return changed
+}
+
+// Private
+
+func (v *module_) compareLines(current, generated []string) (edits []string) {
+	// Each edit is a line prefixed by " " (unchanged), "-" (removed) or "+"
+	// (added).  Any common prefix and suffix is set aside first so that only
+	// the changed region needs a longest common subsequence table.
+	var prefix = 0
+	for prefix < len(current) && prefix < len(generated) &&
+		current[prefix] == generated[prefix] {
+		prefix++
+	}
+	var suffix = 0
+	for suffix < len(current)-prefix && suffix < len(generated)-prefix &&
+		current[len(current)-1-suffix] == generated[len(generated)-1-suffix] {
+		suffix++
+	}
+	for _, line := range current[:prefix] {
+		edits = append(edits, " "+line)
+	}
+	var before = current[prefix : len(current)-suffix]
+	var after = generated[prefix : len(generated)-suffix]
+	var lengths = make([][]int, len(before)+1)
+	for i := range lengths {
+		lengths[i] = make([]int, len(after)+1)
+	}
+	for i := len(before) - 1; i >= 0; i-- {
+		for j := len(after) - 1; j >= 0; j-- {
+			switch {
+			case before[i] == after[j]:
+				lengths[i][j] = lengths[i+1][j+1] + 1
+			case lengths[i+1][j] >= lengths[i][j+1]:
+				lengths[i][j] = lengths[i+1][j]
+			default:
+				lengths[i][j] = lengths[i][j+1]
+			}
+		}
+	}
+	var i, j int
+	for i < len(before) || j < len(after) {
+		switch {
+		case i < len(before) && j < len(after) && before[i] == after[j]:
+			edits = append(edits, " "+before[i])
+			i++
+			j++
+		case j == len(after) || (i < len(before) && lengths[i+1][j] >= lengths[i][j+1]):
+			edits = append(edits, "-"+before[i])
+			i++
+		default:
+			edits = append(edits, "+"+after[j])
+			j++
+		}
+	}
+	for _, line := range current[len(current)-suffix:] {
+		edits = append(edits, " "+line)
+	}
+	return edits
+}
+
+func (v *module_) formatSource(path string, source string) string {
+	var bytes, err = gof.Source([]byte(source))
+	if err != nil {
+		var message = fmt.Sprintf(
+			"The generated file %q could not be formatted: %v",
+			path,
+			err,
+		)
+		panic(message)
+	}
+	return string(bytes)
+}
+
+func (v *module_) generateFiles(
+	module string,
+	wiki string,
+	syntax ast.SyntaxLike,
+) abs.CatalogLike[string, string] {
+	var files = col.Catalog[string, string]()
+
+	// Generate the AST model and a class for each of its rules.
+	var templates = v.templates_
+	var astModel = Ast().MakeWithTemplates(templates)
+	files.SetValue("ast/Package.go", astModel.GenerateAstModel(wiki, syntax))
+	v.analyzer_.AnalyzeSyntax(syntax)
+	var classNames = col.List[string](v.analyzer_.GetRuleNames())
+	classNames.AppendValues(col.List[string]([]string{"Position", "Span"}))
+	var node = Node().MakeWithTemplates(templates)
+	var iterator = classNames.GetIterator()
+	for iterator.HasNext() {
+		var className = iterator.GetNext()
+		var filename = "ast/" + sts.ToLower(className) + ".go"
+		files.SetValue(filename, node.GenerateNodeClass(syntax, className))
+	}
+
+	// Generate the grammar model and its classes.
+	var grammar = Grammar().MakeWithTemplates(templates)
+	files.SetValue("grammar/Package.go", grammar.GenerateGrammarModel(module, wiki, syntax))
+	var formatter = Formatter().MakeWithTemplates(templates)
+	files.SetValue("grammar/formatter.go", formatter.GenerateFormatterClass(module, syntax))
+	var parseError = ParseError().MakeWithTemplates(templates)
+	files.SetValue("grammar/parseerror.go", parseError.GenerateParseErrorClass(module, syntax))
+	var parser = Parser().MakeWithTemplates(templates)
+	files.SetValue("grammar/parser.go", parser.GenerateParserClass(module, syntax))
+	var processor = Processor().MakeWithTemplates(templates)
+	files.SetValue("grammar/processor.go", processor.GenerateProcessorClass(module, syntax))
+	var scanner = Scanner().MakeWithTemplates(templates)
+	files.SetValue("grammar/scanner.go", scanner.GenerateScannerClass(module, syntax))
+	var token = Token().MakeWithTemplates(templates)
+	files.SetValue("grammar/token.go", token.GenerateTokenClass(module, syntax))
+	var validator = Validator().MakeWithTemplates(templates)
+	files.SetValue("grammar/validator.go", validator.GenerateValidatorClass(module, syntax))
+	var visitor = Visitor().MakeWithTemplates(templates)
+	files.SetValue("grammar/visitor.go", visitor.GenerateVisitorClass(module, syntax))
+	return files
+}
+
+func (v *module_) generateDifference(
+	path string,
+	exists bool,
+	current string,
+	generated string,
+) string {
+	var builder sts.Builder
+	var original = path
+	if !exists {
+		original = "/dev/null"
+	}
+	fmt.Fprintf(&builder, "--- %v\n", original)
+	fmt.Fprintf(&builder, "+++ %v (generated)\n", path)
+
+	// Group the changed lines into hunks with up to three lines of context.
+	var edits = v.compareLines(v.splitLines(current), v.splitLines(generated))
+	var first = 0
+	for first < len(edits) {
+		if edits[first][0] == ' ' {
+			first++
+			continue
+		}
+		var last = first
+		for next := first + 1; next < len(edits) && next <= last+2*context_+1; next++ {
+			if edits[next][0] != ' ' {
+				last = next
+			}
+		}
+		var start = max(first-context_, 0)
+		var end = min(last+context_+1, len(edits))
+		var oldLine, newLine = 1, 1
+		for _, edit := range edits[:start] {
+			if edit[0] != '+' {
+				oldLine++
+			}
+			if edit[0] != '-' {
+				newLine++
+			}
+		}
+		var oldCount, newCount int
+		for _, edit := range edits[start:end] {
+			if edit[0] != '+' {
+				oldCount++
+			}
+			if edit[0] != '-' {
+				newCount++
+			}
+		}
+		if oldCount == 0 {
+			oldLine--
+		}
+		if newCount == 0 {
+			newLine--
+		}
+		fmt.Fprintf(
+			&builder,
+			"@@ -%v,%v +%v,%v @@\n",
+			oldLine,
+			oldCount,
+			newLine,
+			newCount,
+		)
+		for _, edit := range edits[start:end] {
+			builder.WriteString(edit)
+			if !sts.HasSuffix(edit, "\n") {
+				builder.WriteString("\n\\ No newline at end of file\n")
+			}
+		}
+		first = end
+	}
+	return builder.String()
+}
+
+func (v *module_) readFile(path string) (source string, exists bool) {
+	var bytes, err = osx.ReadFile(path)
+	if osx.IsNotExist(err) {
+		return "", false
+	}
+	if err != nil {
+		var message = fmt.Sprintf(
+			"The file %q could not be read: %v",
+			path,
+			err,
+		)
+		panic(message)
+	}
+	return string(bytes), true
+}
+
+func (v *module_) splitLines(source string) []string {
+	var lines = sts.SplitAfter(source, "\n")
+	if lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+	return lines
+}
+
+func (v *module_) writeFile(path string, source string) bool {
+	var current, exists = v.readFile(path)
+	if exists {
+		// Keep any hand-written declarations that are marked as preserved.
+		source = v.merger_.MergeSource(current, source)
+	}
+	if current == source {
+		// The file is already up to date.
+		return false
+	}
+	var err = osx.MkdirAll(fil.Dir(path), 0755)
+	if err == nil {
+		err = osx.WriteFile(path, []byte(source), 0644)
+	}
+	if err != nil {
+		var message = fmt.Sprintf(
+			"The generated file %q could not be written: %v",
+			path,
+			err,
+		)
+		panic(message)
+	}
+	return true
+}
+
+// PRIVATE GLOBALS
+
+// Constants
+
+const context_ = 3
@@ -0,0 +1,489 @@
+/*
+................................................................................
+.    Copyright (c) 2009-2024 Crater Dog Technologies.  All Rights Reserved.    .
+................................................................................
+.  DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               .
+.                                                                              .
+.  This code is free software; you can redistribute it and/or modify it under  .
+.  the terms of The MIT License (MIT), as published by the Open Source         .
+.  Initiative. (See https://opensource.org/license/MIT)                        .
+................................................................................
+*/
+
+package generator
+
+import (
+	col "github.com/craterdog/go-collection-framework/v4"
+	ast "github.com/craterdog/go-grammar-framework/v4/ast"
+	sts "strings"
+)
+
+// CLASS ACCESS
+
+// Reference
+
+var nodeClass = &nodeClass_{
+	// Initialize the class constants.
+}
+
+// Function
+
+func Node() NodeClassLike {
+	return nodeClass
+}
+
+// CLASS METHODS
+
+// Target
+
+type nodeClass_ struct {
+	// Define the class constants.
+}
+
+// Constructors
+
+func (c *nodeClass_) Make() NodeLike {
+	return c.MakeWithTemplates(Templates().Make())
+}
+
+func (c *nodeClass_) MakeWithTemplates(templates TemplatesLike) NodeLike {
+	return &node_{
+		// Initialize the instance attributes.
+		class_:     c,
+		analyzer_:  Analyzer().Make(),
+		templates_: templates,
+	}
+}
+
+// INSTANCE METHODS
+
+// Target
+
+type node_ struct {
+	// Define the instance attributes.
+	class_     *nodeClass_
+	analyzer_  AnalyzerLike
+	templates_ TemplatesLike
+}
+
+// Public
+
+func (v *node_) GetClass() NodeClassLike {
+	return v.class_
+}
+
+func (v *node_) GenerateNodeClass(
+	syntax ast.SyntaxLike,
+	className string,
+) (
+	implementation string,
+) {
+	v.analyzer_.AnalyzeSyntax(syntax)
+	switch className {
+	case "Position":
+		implementation = v.getTemplate(positionTemplate)
+	case "Span":
+		implementation = v.getTemplate(spanTemplate)
+	default:
+		implementation = v.getTemplate(classTemplate)
+		var parameters, arguments, validations, initializations, attributes, getters string
+		var isRequired, isPlural bool
+		var names, types, required = v.extractAttributes(className)
+		for index, name := range names {
+			var attributeType = types[index]
+			parameters += v.expandTemplate(parameterTemplate, name, attributeType)
+			arguments += v.expandTemplate(argumentTemplate, name, attributeType)
+			initializations += v.expandTemplate(initializationTemplate, name, attributeType)
+			attributes += v.expandTemplate(attributeTemplate, name, attributeType)
+			getters += v.expandTemplate(getterTemplate, name, attributeType)
+			if required[index] {
+				isRequired = true
+				validations += v.expandTemplate(validationTemplate, name, attributeType)
+			}
+			if sts.HasPrefix(attributeType, "abs.") {
+				isPlural = true
+			}
+		}
+		var spanParameters = "\n\tspan SpanLike," + parameters + "\n"
+		arguments = sts.TrimSuffix(arguments, ", ")
+		if len(names) > 1 {
+			// Multiple parameters are placed on separate lines.
+			parameters += "\n"
+		} else {
+			parameters = sts.TrimSuffix(sts.TrimPrefix(parameters, "\n\t"), ",")
+		}
+		var imports = v.generateImports(isRequired, isPlural)
+		implementation = replaceAll(implementation, "imports", imports)
+		implementation = replaceAll(implementation, "parameters", parameters)
+		implementation = replaceAll(implementation, "spanParameters", spanParameters)
+		implementation = replaceAll(implementation, "arguments", arguments)
+		implementation = replaceAll(implementation, "validations", validations)
+		implementation = replaceAll(implementation, "initializations", initializations)
+		implementation = replaceAll(implementation, "attributes", attributes)
+		implementation = replaceAll(implementation, "getters", getters)
+		implementation = replaceAll(implementation, "className", className)
+	}
+	var notice = v.analyzer_.GetNotice()
+	implementation = replaceAll(implementation, "notice", notice)
+	implementation = removeUnusedImports(implementation)
+	return implementation
+}
+
+// Private
+
+func (v *node_) expandTemplate(
+	name string,
+	attributeName string,
+	attributeType string,
+) string {
+	var template = v.getTemplate(name)
+	template = replaceAll(template, "attributeName", attributeName)
+	template = sts.ReplaceAll(template, "<AttributeType>", attributeType) // Preserves the case.
+	return template
+}
+
+func (v *node_) extractAttributes(
+	className string,
+) (
+	names []string,
+	types []string,
+	required []bool,
+) {
+	var references = v.analyzer_.GetReferences(className)
+	var precedenceRule = v.analyzer_.GetPrecedenceRule(className)
+	switch {
+	case col.IsDefined(references):
+		// This class represents an inline rule.
+		var iterator = references.GetIterator()
+		var variableNames = generateVariableNames(references).GetIterator()
+		for iterator.HasNext() {
+			var reference = iterator.GetNext()
+			var attributeType = generateVariableType(reference)
+			var isRequired = true
+			var cardinality = reference.GetOptionalCardinality()
+			if col.IsDefined(cardinality) {
+				switch actual := cardinality.GetAny().(type) {
+				case ast.ConstrainedLike:
+					if actual.GetAny().(string) == "?" {
+						isRequired = false
+					} else {
+						attributeType = "abs.Sequential[" + attributeType + "]"
+					}
+				default:
+					attributeType = "abs.Sequential[" + attributeType + "]"
+				}
+			}
+			names = append(names, variableNames.GetNext())
+			types = append(types, attributeType)
+			required = append(required, isRequired)
+		}
+	case col.IsDefined(precedenceRule):
+		// This class represents a binary operation from a precedence table.
+		var operandType = makeUpperCase(precedenceRule) + "Like"
+		names = []string{"left", "operator", "right"}
+		types = []string{operandType, "string", operandType}
+		required = []bool{true, true, true}
+	default:
+		// This class represents a multiline rule.
+		names = []string{"any"}
+		types = []string{"any"}
+		required = []bool{true}
+	}
+	return names, types, required
+}
+
+func (v *node_) generateImports(isRequired bool, isPlural bool) string {
+	var imports string
+	if isRequired {
+		imports += "\n\tcol \"github.com/craterdog/go-collection-framework/v4\""
+	}
+	if isPlural {
+		imports += "\n\tabs \"github.com/craterdog/go-collection-framework/v4/collection\""
+	}
+	if len(imports) > 0 {
+		imports = "\nimport (" + imports + "\n)\n"
+	}
+	return imports
+}
+
+func (v *node_) getTemplate(name string) string {
+	var template = v.templates_.GetTemplate("node/" + name)
+	return template
+}
+
+// PRIVATE GLOBALS
+
+// Constants
+
+const (
+	attributeTemplate      = "attributeTemplate"
+	getterTemplate         = "getterTemplate"
+	initializationTemplate = "initializationTemplate"
+	parameterTemplate      = "parameterTemplate"
+	positionTemplate       = "positionTemplate"
+	spanTemplate           = "spanTemplate"
+	validationTemplate     = "validationTemplate"
+)
+
+var nodeTemplates_ = col.Catalog[string, string](
+	map[string]string{
+		parameterTemplate: `
+	<attributeName_> <AttributeType>,`,
+		argumentTemplate: `<attributeName_>, `,
+		initializationTemplate: `
+			<attributeName>_: <attributeName_>,`,
+		attributeTemplate: `
+	<attributeName>_ <AttributeType>`,
+		getterTemplate: `
+func (v *<className>_) Get<AttributeName>() <AttributeType> {
+	return v.<attributeName>_
+}
+`,
+		validationTemplate: `
+	case col.IsUndefined(<attributeName_>):
+		panic("The <attributeName> attribute is required by this class.")`,
+		classTemplate: `<Notice>
+
+package ast
+<Imports>
+// CLASS ACCESS
+
+// Reference
+
+var <className>Class = &<className>Class_{
+	// Initialize class constants.
+}
+
+// Function
+
+func <ClassName>() <ClassName>ClassLike {
+	return <className>Class
+}
+
+// CLASS METHODS
+
+// Target
+
+type <className>Class_ struct {
+	// Define class constants.
+}
+
+// Constructors
+
+func (c *<className>Class_) Make(<parameters>) <ClassName>Like {
+	// Validate the arguments.
+	switch {<Validations>
+	default:
+		return &<className>_{
+			// Initialize instance attributes.
+			class_: c,<Initializations>
+		}
+	}
+}
+
+func (c *<className>Class_) MakeWithSpan(<spanParameters>) <ClassName>Like {
+	var instance = c.Make(<arguments>).(*<className>_)
+	instance.span_ = span
+	return instance
+}
+
+// INSTANCE METHODS
+
+// Target
+
+type <className>_ struct {
+	// Define instance attributes.
+	class_ <ClassName>ClassLike<Attributes>
+	span_ SpanLike
+}
+
+// Attributes
+
+func (v *<className>_) GetClass() <ClassName>ClassLike {
+	return v.class_
+}
+<Getters>
+func (v *<className>_) GetSpan() SpanLike {
+	return v.span_
+}
+
+// Private
+`,
+		positionTemplate: `<Notice>
+
+package ast
+
+// CLASS ACCESS
+
+// Reference
+
+var positionClass = &positionClass_{
+	// Initialize class constants.
+}
+
+// Function
+
+func Position() PositionClassLike {
+	return positionClass
+}
+
+// CLASS METHODS
+
+// Target
+
+type positionClass_ struct {
+	// Define class constants.
+}
+
+// Constructors
+
+func (c *positionClass_) Make(
+	line uint,
+	column uint,
+	offset uint,
+) PositionLike {
+	// Validate the arguments.
+	switch {
+	case line == 0:
+		panic("The line attribute must be at least one.")
+	case column == 0:
+		panic("The column attribute must be at least one.")
+	default:
+		return &position_{
+			// Initialize instance attributes.
+			class_:  c,
+			line_:   line,
+			column_: column,
+			offset_: offset,
+		}
+	}
+}
+
+// INSTANCE METHODS
+
+// Target
+
+type position_ struct {
+	// Define instance attributes.
+	class_  PositionClassLike
+	line_   uint
+	column_ uint
+	offset_ uint
+}
+
+// Attributes
+
+func (v *position_) GetClass() PositionClassLike {
+	return v.class_
+}
+
+func (v *position_) GetLine() uint {
+	return v.line_
+}
+
+func (v *position_) GetColumn() uint {
+	return v.column_
+}
+
+func (v *position_) GetOffset() uint {
+	return v.offset_
+}
+
+// Private
+`,
+		spanTemplate: `<Notice>
+
+package ast
+
+import (
+	col "github.com/craterdog/go-collection-framework/v4"
+)
+
+// CLASS ACCESS
+
+// Reference
+
+var spanClass = &spanClass_{
+	// Initialize class constants.
+}
+
+// Function
+
+func Span() SpanClassLike {
+	return spanClass
+}
+
+// CLASS METHODS
+
+// Target
+
+type spanClass_ struct {
+	// Define class constants.
+}
+
+// Constructors
+
+func (c *spanClass_) Make(
+	start PositionLike,
+	end PositionLike,
+) SpanLike {
+	// Validate the arguments.
+	switch {
+	case col.IsUndefined(start):
+		panic("The start attribute is required by this class.")
+	case col.IsUndefined(end):
+		panic("The end attribute is required by this class.")
+	default:
+		return &span_{
+			// Initialize instance attributes.
+			class_: c,
+			start_: start,
+			end_:   end,
+		}
+	}
+}
+
+func (c *spanClass_) MakeWithSource(
+	source string,
+	start PositionLike,
+	end PositionLike,
+) SpanLike {
+	var instance = c.Make(start, end).(*span_)
+	instance.source_ = source
+	return instance
+}
+
+// INSTANCE METHODS
+
+// Target
+
+type span_ struct {
+	// Define instance attributes.
+	class_  SpanClassLike
+	source_ string
+	start_  PositionLike
+	end_    PositionLike
+}
+
+// Attributes
+
+func (v *span_) GetClass() SpanClassLike {
+	return v.class_
+}
+
+func (v *span_) GetSource() string {
+	return v.source_
+}
+
+func (v *span_) GetStart() PositionLike {
+	return v.start_
+}
+
+func (v *span_) GetEnd() PositionLike {
+	return v.end_
+}
+
+// Private
+`,
+	},
+)
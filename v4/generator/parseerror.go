@@ -0,0 +1,230 @@
+/*
+................................................................................
+.    Copyright (c) 2009-2024 Crater Dog Technologies.  All Rights Reserved.    .
+................................................................................
+.  DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               .
+.                                                                              .
+.  This code is free software; you can redistribute it and/or modify it under  .
+.  the terms of The MIT License (MIT), as published by the Open Source         .
+.  Initiative. (See https://opensource.org/license/MIT)                        .
+................................................................................
+*/
+
+package generator
+
+// CLASS ACCESS
+
+import (
+	col "github.com/craterdog/go-collection-framework/v4"
+	ast "github.com/craterdog/go-grammar-framework/v4/ast"
+)
+
+// Reference
+
+var parseErrorClass = &parseErrorClass_{
+	// Initialize the class constants.
+}
+
+// Function
+
+func ParseError() ParseErrorClassLike {
+	return parseErrorClass
+}
+
+// CLASS METHODS
+
+// Target
+
+type parseErrorClass_ struct {
+	// Define the class constants.
+}
+
+// Constructors
+
+func (c *parseErrorClass_) Make() ParseErrorLike {
+	return c.MakeWithTemplates(Templates().Make())
+}
+
+func (c *parseErrorClass_) MakeWithTemplates(templates TemplatesLike) ParseErrorLike {
+	return &parseError_{
+		// Initialize the instance attributes.
+		class_:     c,
+		analyzer_:  Analyzer().Make(),
+		templates_: templates,
+	}
+}
+
+// INSTANCE METHODS
+
+// Target
+
+type parseError_ struct {
+	// Define the instance attributes.
+	class_     *parseErrorClass_
+	analyzer_  AnalyzerLike
+	templates_ TemplatesLike
+}
+
+// Public
+
+func (v *parseError_) GetClass() ParseErrorClassLike {
+	return v.class_
+}
+
+func (v *parseError_) GenerateParseErrorClass(
+	module string,
+	syntax ast.SyntaxLike,
+) (
+	implementation string,
+) {
+	v.analyzer_.AnalyzeSyntax(syntax)
+	var notice = v.analyzer_.GetNotice()
+	var template = v.getTemplate(classTemplate)
+	implementation = replaceAll(template, "notice", notice)
+	implementation = removeUnusedImports(implementation)
+	return implementation
+}
+
+// Private
+
+func (v *parseError_) getTemplate(name string) string {
+	var template = v.templates_.GetTemplate("parseerror/" + name)
+	return template
+}
+
+// PRIVATE GLOBALS
+
+// Constants
+
+var parseErrorTemplates_ = col.Catalog[string, string](
+	map[string]string{
+		classTemplate: `<Notice>
+
+package grammar
+
+import (
+	fmt "fmt"
+)
+
+// CLASS ACCESS
+
+// Reference
+
+var parseErrorClass = &parseErrorClass_{
+	// Initialize the class constants.
+}
+
+// Function
+
+func ParseError() ParseErrorClassLike {
+	return parseErrorClass
+}
+
+// CLASS METHODS
+
+// Target
+
+type parseErrorClass_ struct {
+	// Define the class constants.
+}
+
+// Constructors
+
+func (c *parseErrorClass_) Make(
+	line uint,
+	position uint,
+	token TokenLike,
+	ruleName string,
+	expected string,
+	message string,
+) ParseErrorLike {
+	return &parseError_{
+		// Initialize the instance attributes.
+		class_:    c,
+		line_:     line,
+		position_: position,
+		token_:    token,
+		ruleName_: ruleName,
+		expected_: expected,
+		message_:  message,
+	}
+}
+
+// INSTANCE METHODS
+
+// Target
+
+type parseError_ struct {
+	// Define the instance attributes.
+	class_    *parseErrorClass_
+	line_     uint
+	position_ uint
+	token_    TokenLike // This is nil if the end of the source was reached.
+	ruleName_ string
+	expected_ string
+	message_  string
+}
+
+// Public
+
+func (v *parseError_) GetClass() ParseErrorClassLike {
+	return v.class_
+}
+
+func (v *parseError_) Error() string {
+	var found = "end of source"
+	if v.token_ != nil {
+		var value = v.token_.GetValue()
+		if len(value) < 2 || value[0] != '"' || value[len(value)-1] != '"' {
+			// A literal value is already quoted so it is not quoted again.
+			value = fmt.Sprintf("%q", value)
+		}
+		found = Scanner().FormatType(v.token_.GetType()) + " " + value
+	}
+	var result = fmt.Sprintf(
+		"%d:%d: unexpected %v",
+		v.line_,
+		v.position_,
+		found,
+	)
+	if len(v.ruleName_) > 0 {
+		result += fmt.Sprintf(
+			" while parsing %v: %v",
+			v.ruleName_,
+			v.expected_,
+		)
+	}
+	if len(v.message_) > 0 {
+		result += " (" + v.message_ + ")"
+	}
+	return result
+}
+
+// Attributes
+
+func (v *parseError_) GetLine() uint {
+	return v.line_
+}
+
+func (v *parseError_) GetPosition() uint {
+	return v.position_
+}
+
+func (v *parseError_) GetToken() TokenLike {
+	return v.token_
+}
+
+func (v *parseError_) GetRuleName() string {
+	return v.ruleName_
+}
+
+func (v *parseError_) GetExpected() string {
+	return v.expected_
+}
+
+func (v *parseError_) GetMessage() string {
+	return v.message_
+}
+`,
+	},
+)
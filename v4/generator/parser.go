@@ -14,9 +14,11 @@ package generator
 
 import (
 	col "github.com/craterdog/go-collection-framework/v4"
+	abs "github.com/craterdog/go-collection-framework/v4/collection"
 	ast "github.com/craterdog/go-grammar-framework/v4/ast"
 	gra "github.com/craterdog/go-grammar-framework/v4/grammar"
 	stc "strconv"
+	sts "strings"
 )
 
 // CLASS ACCESS
@@ -44,10 +46,15 @@ type parserClass_ struct {
 // Constructors
 
 func (c *parserClass_) Make() ParserLike {
+	return c.MakeWithTemplates(Templates().Make())
+}
+
+func (c *parserClass_) MakeWithTemplates(templates TemplatesLike) ParserLike {
 	var parser = &parser_{
 		// Initialize the instance attributes.
-		class_:    c,
-		analyzer_: Analyzer().Make(),
+		class_:     c,
+		analyzer_:  Analyzer().Make(),
+		templates_: templates,
 	}
 	return parser
 }
@@ -58,8 +65,9 @@ func (c *parserClass_) Make() ParserLike {
 
 type parser_ struct {
 	// Define the instance attributes.
-	class_    *parserClass_
-	analyzer_ AnalyzerLike
+	class_     *parserClass_
+	analyzer_  AnalyzerLike
+	templates_ TemplatesLike
 }
 
 // Public
@@ -83,8 +91,21 @@ func (v *parser_) GenerateParserClass(
 	implementation = replaceAll(implementation, "syntaxName", syntaxName)
 	var syntaxMap = v.analyzer_.GetSyntaxMap()
 	implementation = replaceAll(implementation, "syntaxMap", syntaxMap)
+	var caselessDelimiters = v.generateCaselessDelimiters()
+	implementation = replaceAll(implementation, "caselessDelimiters", caselessDelimiters)
 	var methods = v.generateMethods()
 	implementation = replaceAll(implementation, "methods", methods)
+	var firsts, follows = v.generateRecoveries()
+	implementation = replaceAll(implementation, "firsts", firsts)
+	implementation = replaceAll(implementation, "follows", follows)
+	var ignoredCases = v.generateIgnoredCases()
+	implementation = replaceAll(implementation, "ignoredCases", ignoredCases)
+	var indentationCheck string
+	if v.analyzer_.IsIndented() {
+		indentationCheck = v.getTemplate(indentationError)
+	}
+	implementation = replaceAll(implementation, "indentationError", indentationCheck)
+	implementation = removeUnusedImports(implementation)
 	return implementation
 }
 
@@ -95,37 +116,54 @@ func (v *parser_) generateArguments(
 ) (
 	arguments string,
 ) {
+	// Each argument follows the span of the rule on a separate line.
 	var references = v.analyzer_.GetReferences(rule)
 	var variableNames = generateVariableNames(references).GetIterator()
-	if variableNames.IsEmpty() {
-		return arguments
+	for variableNames.HasNext() {
+		var template = v.getTemplate(argumentTemplate)
+		var argument = variableNames.GetNext()
+		arguments += "\n\t\t" + replaceAll(template, "argument", argument) + ","
 	}
+	return arguments
+}
 
-	// Define the first argument.
-	if variableNames.GetSize() > 1 {
-		// Use the multiline argument style.
-		arguments += "\n\t\t"
+func (v *parser_) generateCaselessDelimiters() (
+	caselessDelimiters string,
+) {
+	var delimiters = v.analyzer_.GetCaselessDelimiters().GetIterator()
+	for delimiters.HasNext() {
+		if delimiters.GetSlot() > 0 {
+			caselessDelimiters += ", "
+		}
+		caselessDelimiters += stc.Quote(delimiters.GetNext())
 	}
-	var template = v.getTemplate(argumentTemplate)
-	var argument = variableNames.GetNext()
-	arguments += replaceAll(template, "argument", argument)
+	return caselessDelimiters
+}
 
-	// Define any additional arguments.
-	for variableNames.HasNext() {
-		arguments += ",\n\t\t"
-		template = v.getTemplate(argumentTemplate)
-		argument = variableNames.GetNext()
-		arguments += replaceAll(template, "argument", argument)
+func (v *parser_) generateIgnoredCases() (
+	implementation string,
+) {
+	// Whitespace tokens are ignored unless they are referenced by a rule.
+	var ignoredTypes string
+	var tokenNames = []string{"space", "newline"}
+	for _, tokenName := range tokenNames {
+		if v.isReferenced(tokenName) {
+			continue
+		}
+		if len(ignoredTypes) > 0 {
+			ignoredTypes += ", "
+		}
+		ignoredTypes += makeUpperCase(tokenName) + "Token"
 	}
-	if variableNames.GetSize() > 1 {
-		// Use the multiline argument style.
-		arguments += ",\n\t"
+	if len(ignoredTypes) > 0 {
+		implementation = v.getTemplate(ignoredCases)
+		implementation = replaceAll(implementation, "ignoredTypes", ignoredTypes)
 	}
-
-	return arguments
+	return implementation
 }
 
 func (v *parser_) generateInlineRule(
+	rule string,
 	variableName string,
 	reference ast.ReferenceLike,
 ) (
@@ -133,6 +171,15 @@ func (v *parser_) generateInlineRule(
 ) {
 	var optionalRuleTemplate = v.getTemplate(parseOptionalRule)
 	var repeatedRuleTemplate = v.getTemplate(parseRepeatedRule)
+	if rule == v.analyzer_.GetSyntaxName() {
+		// The syntax rule recovers from syntax errors in its repeated rules.
+		repeatedRuleTemplate = v.getTemplate(parseRecoveredRule)
+	}
+	var separator = reference.GetOptionalSeparator()
+	if col.IsDefined(separator) {
+		// The separated rules are parsed into a single list.
+		repeatedRuleTemplate = v.generateSeparator(separator, parseSeparatedRule, missingRule)
+	}
 	implementation = v.getTemplate(parseRule)
 	var cardinality = reference.GetOptionalCardinality()
 	if col.IsDefined(cardinality) {
@@ -156,6 +203,11 @@ func (v *parser_) generateInlineToken(
 ) {
 	var optionalTokenTemplate = v.getTemplate(parseOptionalToken)
 	var repeatedTokenTemplate = v.getTemplate(parseRepeatedToken)
+	var separator = reference.GetOptionalSeparator()
+	if col.IsDefined(separator) {
+		// The separated tokens are parsed into a single list.
+		repeatedTokenTemplate = v.generateSeparator(separator, parseSeparatedToken, missingToken)
+	}
 	implementation = v.getTemplate(parseToken)
 	var cardinality = reference.GetOptionalCardinality()
 	if col.IsDefined(cardinality) {
@@ -180,9 +232,9 @@ func (v *parser_) generateInlineCardinality(
 ) {
 	var first string
 	var last = "unlimited"
+	implementation = repeatedTemplate
 	switch actual := cardinality.GetAny().(type) {
 	case ast.ConstrainedLike:
-		implementation = repeatedTemplate
 		switch actual.GetAny().(string) {
 		case "?":
 			// This is the "{0..1}" case.
@@ -228,9 +280,11 @@ func (v *parser_) generateInlineMethod(
 	for terms.HasNext() {
 		var term = terms.GetNext()
 		switch actual := term.GetAny().(type) {
+		case ast.LookaheadLike:
+			implementation += v.generateInlineLookahead(actual)
 		case ast.ReferenceLike:
 			var variableName = variableNames.GetNext()
-			implementation += v.generateInlineReference(variableName, actual)
+			implementation += v.generateInlineReference(rule, variableName, actual)
 		case string:
 			implementation += v.generateInlineLiteral(actual)
 		}
@@ -250,12 +304,41 @@ func (v *parser_) generateInlineLiteral(
 ) (
 	implementation string,
 ) {
-	var delimiter, err = stc.Unquote(literal) // Remove the double quotes.
-	if err != nil {
-		panic(err)
-	}
+	var delimiter, _ = unquoteLiteral(literal)
 	implementation = v.getTemplate(parseDelimiter)
-	implementation = replaceAll(implementation, "delimiter", delimiter)
+	implementation = replaceAll(implementation, "kind", v.getKind(delimiter))
+	implementation = sts.ReplaceAll(implementation, "<delimiter>", delimiter) // Preserve its case.
+	return implementation
+}
+
+func (v *parser_) generateInlineLookahead(
+	lookahead ast.LookaheadLike,
+) (
+	implementation string,
+) {
+	implementation = v.getTemplate(parseLookahead)
+	if lookahead.GetPredicate() == "!" {
+		implementation = v.getTemplate(parseNegativeLookahead)
+	}
+	var lookaheadCall string
+	switch actual := lookahead.GetTerm().GetAny().(type) {
+	case ast.ReferenceLike:
+		var identifier = actual.GetIdentifier().GetAny().(string)
+		switch {
+		case gra.Scanner().MatchesType(identifier, gra.LowercaseToken):
+			lookaheadCall = v.getTemplate(lookaheadToken)
+			lookaheadCall = replaceAll(lookaheadCall, "tokenName", identifier)
+		case gra.Scanner().MatchesType(identifier, gra.UppercaseToken):
+			lookaheadCall = v.getTemplate(lookaheadRule)
+			lookaheadCall = replaceAll(lookaheadCall, "ruleName", identifier)
+		}
+	case string:
+		var delimiter, _ = unquoteLiteral(actual)
+		lookaheadCall = v.getTemplate(lookaheadDelimiter)
+		lookaheadCall = replaceAll(lookaheadCall, "kind", v.getKind(delimiter))
+		lookaheadCall = sts.ReplaceAll(lookaheadCall, "<delimiter>", delimiter)
+	}
+	implementation = replaceAll(implementation, "lookaheadCall", lookaheadCall)
 	return implementation
 }
 
@@ -267,6 +350,8 @@ func (v *parser_) generateMethods() (
 		var method string
 		var rule = rules.GetNext()
 		switch {
+		case col.IsDefined(v.analyzer_.GetPrecedence(rule)):
+			method = v.generatePrecedenceMethod(rule)
 		case col.IsDefined(v.analyzer_.GetIdentifiers(rule)):
 			method = v.generateMultilineMethod(rule)
 		case col.IsDefined(v.analyzer_.GetReferences(rule)):
@@ -333,6 +418,7 @@ func (v *parser_) generateMultilineToken(
 }
 
 func (v *parser_) generateInlineReference(
+	rule string,
 	variableName string,
 	reference ast.ReferenceLike,
 ) (
@@ -343,16 +429,169 @@ func (v *parser_) generateInlineReference(
 	case gra.Scanner().MatchesType(identifier, gra.LowercaseToken):
 		implementation = v.generateInlineToken(variableName, reference)
 	case gra.Scanner().MatchesType(identifier, gra.UppercaseToken):
-		implementation = v.generateInlineRule(variableName, reference)
+		implementation = v.generateInlineRule(rule, variableName, reference)
 	}
 	return implementation
 }
 
+func (v *parser_) generatePrecedenceMethod(
+	ruleName string,
+) (
+	method string,
+) {
+	// Parse the operand.
+	var precedence = v.analyzer_.GetPrecedence(ruleName)
+	var operand = precedence.GetIdentifier().GetAny().(string)
+	var implementation = v.getTemplate(parseRuleOperand)
+	if gra.Scanner().MatchesType(operand, gra.LowercaseToken) {
+		implementation = v.getTemplate(parseTokenOperand)
+	}
+	implementation = replaceAll(implementation, "operandName", operand)
+
+	// Assign each operator its level of precedence starting with one.
+	var levelCases string
+	var levels = precedence.GetLevels().GetIterator()
+	for levels.HasNext() {
+		var level = levels.GetNext()
+		var literals string
+		var operators = level.GetOperators().GetIterator()
+		for operators.HasNext() {
+			if operators.GetSlot() > 0 {
+				literals += ", "
+			}
+			var delimiter, _ = unquoteLiteral(operators.GetNext().GetLiteral())
+			literals += stc.Quote(delimiter)
+		}
+		var levelCase = v.getTemplate(operatorCase)
+		levelCase = replaceAll(levelCase, "level", stc.Itoa(levels.GetSlot()))
+		levelCase = replaceAll(levelCase, "associativity", level.GetAssociativity())
+		levelCase = replaceAll(levelCase, "operators", literals)
+		levelCases += levelCase
+	}
+	method = v.getTemplate(precedenceRuleMethod)
+	method = replaceAll(method, "implementation", implementation)
+	method = replaceAll(method, "levelCases", levelCases)
+	return method
+}
+
+func (v *parser_) generateRecoveries() (
+	firsts string,
+	follows string,
+) {
+	// The syntax rule recovers from syntax errors in its repeated rules.
+	var syntaxName = v.analyzer_.GetSyntaxName()
+	var references = v.analyzer_.GetReferences(syntaxName)
+	if col.IsUndefined(references) {
+		return firsts, follows
+	}
+	var iterator = references.GetIterator()
+	for iterator.HasNext() {
+		var reference = iterator.GetNext()
+		var ruleName = reference.GetIdentifier().GetAny().(string)
+		var cardinality = reference.GetOptionalCardinality()
+		if !gra.Scanner().MatchesType(ruleName, gra.UppercaseToken) ||
+			col.IsUndefined(cardinality) ||
+			col.IsDefined(reference.GetOptionalSeparator()) {
+			continue
+		}
+		if constrained, ok := cardinality.GetAny().(ast.ConstrainedLike); ok &&
+			constrained.GetAny().(string) == "?" {
+			continue
+		}
+		firsts += v.generateTokens(ruleName, v.analyzer_.GetFirst(ruleName))
+		follows += v.generateTokens(ruleName, v.analyzer_.GetFollow(ruleName))
+	}
+	return firsts, follows
+}
+
+func (v *parser_) generateSeparator(
+	separator ast.SeparatorLike,
+	separatedTemplate string,
+	missingTemplate string,
+) (
+	implementation string,
+) {
+	var delimiter, _ = unquoteLiteral(separator.GetLiteral())
+	var missingCase = v.getTemplate(missingTemplate)
+	if col.IsDefined(separator.GetOptionalTrailing()) {
+		// A trailing separator need not be followed by another instance.
+		missingCase = ""
+	}
+	implementation = v.getTemplate(separatedTemplate)
+	implementation = replaceAll(implementation, "missingCase", missingCase)
+	implementation = replaceAll(implementation, "kind", v.getKind(delimiter))
+	implementation = sts.ReplaceAll(implementation, "<separator>", delimiter)
+	return implementation
+}
+
+func (v *parser_) generateTokens(
+	ruleName string,
+	tokens abs.Sequential[string],
+) (
+	implementation string,
+) {
+	implementation = "\n\t\t\"" + ruleName + "\": {"
+	var iterator = tokens.GetIterator()
+	for iterator.HasNext() {
+		var token = iterator.GetNext()
+		if token == "<EOF>" {
+			// The end of the source stops the synchronization anyway.
+			continue
+		}
+		if !sts.HasSuffix(implementation, "{") {
+			implementation += ", "
+		}
+		if sts.HasPrefix(token, "\"") && !sts.Contains(token, "`") {
+			// A literal is easier to read as a raw string.
+			implementation += "`" + token + "`"
+		} else {
+			implementation += stc.Quote(token)
+		}
+	}
+	implementation += "},"
+	return implementation
+}
+
+func (v *parser_) getKind(delimiter string) string {
+	if isKeyword(delimiter) {
+		return "keyword"
+	}
+	return "delimiter"
+}
+
 func (v *parser_) getTemplate(name string) string {
-	var template = parserTemplates_.GetValue(name)
+	var template = v.templates_.GetTemplate("parser/" + name)
 	return template
 }
 
+func (v *parser_) isReferenced(tokenName string) bool {
+	var rules = v.analyzer_.GetRuleNames().GetIterator()
+	for rules.HasNext() {
+		var rule = rules.GetNext()
+		var identifiers = v.analyzer_.GetIdentifiers(rule)
+		if col.IsDefined(identifiers) {
+			var iterator = identifiers.GetIterator()
+			for iterator.HasNext() {
+				var identifier = iterator.GetNext()
+				if identifier.GetAny().(string) == tokenName {
+					return true
+				}
+			}
+		}
+		var references = v.analyzer_.GetReferences(rule)
+		if col.IsDefined(references) {
+			var iterator = references.GetIterator()
+			for iterator.HasNext() {
+				var reference = iterator.GetNext()
+				if reference.GetIdentifier().GetAny().(string) == tokenName {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
 // PRIVATE GLOBALS
 
 // Constants
@@ -360,13 +599,27 @@ func (v *parser_) getTemplate(name string) string {
 const (
 	inlineRuleMethod       = "inlineRuleMethod"
 	parseDelimiter         = "parseDelimiter"
+	parseLookahead         = "parseLookahead"
+	parseNegativeLookahead = "parseNegativeLookahead"
+	lookaheadDelimiter     = "lookaheadDelimiter"
+	lookaheadRule          = "lookaheadRule"
+	lookaheadToken         = "lookaheadToken"
 	parseRule              = "parseRule"
 	parseOptionalRule      = "parseOptionalRule"
 	parseRepeatedRule      = "parseRepeatedRule"
+	parseRecoveredRule     = "parseRecoveredRule"
+	parseSeparatedRule     = "parseSeparatedRule"
+	missingRule            = "missingRule"
 	parseToken             = "parseToken"
 	parseOptionalToken     = "parseOptionalToken"
 	parseRepeatedToken     = "parseRepeatedToken"
+	parseSeparatedToken    = "parseSeparatedToken"
+	missingToken           = "missingToken"
 	multilineRuleMethod    = "multilineRuleMethod"
+	precedenceRuleMethod   = "precedenceRuleMethod"
+	parseRuleOperand       = "parseRuleOperand"
+	parseTokenOperand      = "parseTokenOperand"
+	operatorCase           = "operatorCase"
 	multilineCases         = "multilineCases"
 	parseSingularRuleCase  = "parseSingularRuleCase"
 	parseRuleCase          = "parseRuleCase"
@@ -375,12 +628,23 @@ const (
 	defaultCase            = "defaultCase"
 	ruleFound              = "ruleFound"
 	argumentTemplate       = "argumentTemplate"
+	ignoredCases           = "ignoredCases"
+	indentationError       = "indentationError"
 )
 
 var parserTemplates_ = col.Catalog[string, string](
 	map[string]string{
 		multilineCases:   `<RuleCases><TokenCases><DefaultCase>`,
 		argumentTemplate: `<argument_>`,
+		ignoredCases: `
+		case <IgnoredTypes>:
+			// Ignore any unspecified whitespace.
+			token = v.getNextToken()`,
+		indentationError: `
+		if len(sts.Trim(token.GetValue(), " \t")) == 0 {
+			// Only an inconsistent indentation produces a blank error token.
+			message = "The indentation does not match any enclosing indentation."
+		}`,
 		parseOptionalRule: `
 	// Attempt to parse an optional <ruleName> rule.
 	var <variableName_> ast.<RuleName>Like
@@ -404,9 +668,67 @@ var parserTemplates_ = col.Catalog[string, string](
 					return <rule_>, token, false
 				}
 				// Found a syntax error.
-				var message = v.formatError(token, "<Rule>")
-				message += "The number of <ruleName> rules must be at least <first>."
-				panic(message)
+				v.reportError(token, "<Rule>", "The number of <ruleName> rules must be at least <first>.")
+			default:
+				break <variableName>Loop
+			}
+		}
+		<variableName_>.AppendValue(<ruleName_>)
+	}
+`,
+		parseRecoveredRule: `
+	// Attempt to parse <first> to <last> <ruleName> rules.
+	var <variableName> = col.List[ast.<RuleName>Like]()
+<variableName>Loop:
+	for numberFound_ := 0; numberFound_ < <last>; numberFound_++ {
+		var <ruleName_> ast.<RuleName>Like
+		if v.attemptRecovery("<RuleName>", func() { <ruleName_>, token, ok = v.parse<RuleName>() }) {
+			// Skip over the invalid <ruleName> rule and continue parsing.
+			continue
+		}
+		if !ok {
+			switch {
+			case numberFound_ < <first>:
+				if !ruleFound_ {
+					// This is not a single <rule> rule.
+					return <rule_>, token, false
+				}
+				// Found a syntax error.
+				v.reportError(token, "<Rule>", "The number of <ruleName> rules must be at least <first>.")
+			default:
+				break <variableName>Loop
+			}
+		}
+		<variableName_>.AppendValue(<ruleName_>)
+	}
+`,
+		parseSeparatedRule: `
+	// Attempt to parse <first> to <last> <ruleName> rules separated by "<separator>".
+	var <variableName> = col.List[ast.<RuleName>Like]()
+<variableName>Loop:
+	for numberFound_ := 0; numberFound_ < <last>; numberFound_++ {
+		if numberFound_ > 0 {
+			// Attempt to parse a single "<separator>" separator.
+			_, token, ok = v.parse<Kind>("<separator>")
+			if !ok {
+				if numberFound_ < <first> {
+					// Found a syntax error.
+					v.reportError(token, "<Rule>", "The number of <ruleName> rules must be at least <first>.")
+				}
+				break <variableName>Loop
+			}
+		}
+		var <ruleName_> ast.<RuleName>Like
+		<ruleName_>, token, ok = v.parse<RuleName>()
+		if !ok {
+			switch {
+			case numberFound_ < <first>:
+				if !ruleFound_ {
+					// This is not a single <rule> rule.
+					return <rule_>, token, false
+				}
+				// Found a syntax error.
+				v.reportError(token, "<Rule>", "The number of <ruleName> rules must be at least <first>.")<MissingCase>
 			default:
 				break <variableName>Loop
 			}
@@ -414,6 +736,10 @@ var parserTemplates_ = col.Catalog[string, string](
 		<variableName_>.AppendValue(<ruleName_>)
 	}
 `,
+		missingRule: `
+			case numberFound_ > 0:
+				// Found a syntax error.
+				v.reportError(token, "<Rule>", "A separator must be followed by another <ruleName> rule.")`,
 		parseOptionalToken: `
 	// Attempt to parse an optional <tokenName> token.
 	var <variableName_> string
@@ -437,14 +763,10 @@ var parserTemplates_ = col.Catalog[string, string](
 					return <rule_>, token, false
 				}
 				// Found a syntax error.
-				var message = v.formatError(token, "<Rule>")
-				message += "Too few <tokenName> tokens found."
-				panic(message)
+				v.reportError(token, "<Rule>", "Too few <tokenName> tokens found.")
 			case i > <last>:
 				// Found a syntax error.
-				var message = v.formatError(token, "<Rule>")
-				message += "Too many <tokenName> tokens found."
-				panic(message)
+				v.reportError(token, "<Rule>", "Too many <tokenName> tokens found.")
 			default:
 				break <variableName>Loop
 			}
@@ -452,10 +774,50 @@ var parserTemplates_ = col.Catalog[string, string](
 		<variableName_>.AppendValue(<tokenName_>)
 	}
 `,
+		parseSeparatedToken: `
+	// Attempt to parse <first> to <last> <tokenName> tokens separated by "<separator>".
+	var <variableName_> = col.List[string]()
+<variableName>Loop:
+	for i := 0; i < <last>; i++ {
+		if i > 0 {
+			// Attempt to parse a single "<separator>" separator.
+			_, token, ok = v.parse<Kind>("<separator>")
+			if !ok {
+				if i < <first> {
+					// Found a syntax error.
+					v.reportError(token, "<Rule>", "Too few <tokenName> tokens found.")
+				}
+				break <variableName>Loop
+			}
+		}
+		var <tokenName_> string
+		<tokenName_>, token, ok = v.parseToken(<TokenName>Token)
+		if !ok {
+			switch {
+			case i < <first>:
+				if !ruleFound_ {
+					// This is not a single <rule> rule.
+					return <rule_>, token, false
+				}
+				// Found a syntax error.
+				v.reportError(token, "<Rule>", "Too few <tokenName> tokens found.")<MissingCase>
+			default:
+				break <variableName>Loop
+			}
+		}
+		<variableName_>.AppendValue(<tokenName_>)
+	}
+`,
+		missingToken: `
+			case i > 0:
+				// Found a syntax error.
+				v.reportError(token, "<Rule>", "A separator must be followed by another <tokenName> token.")`,
 		ruleFound: `
 	// Found a single <rule> rule.
 	ruleFound_ = true
-	<rule_> = ast.<Rule>().Make(<arguments>)
+	<rule_> = ast.<Rule>().MakeWithSpan(
+		v.getSpan(start_),<arguments>
+	)
 	return <rule_>, token, ruleFound_
 `,
 		defaultCase: `
@@ -469,6 +831,7 @@ func (v *parser_) parse<Rule>() (
 	ok bool,
 ) {
 	var ruleFound_ bool
+	var start_ = len(v.parsed_) // The index of the first token in the rule.
 <Implementation>}
 `,
 		multilineRuleMethod: `
@@ -476,17 +839,110 @@ func (v *parser_) parse<Rule>() (
 	<rule_> ast.<Rule>Like,
 	token TokenLike,
 	ok bool,
-) {<Implementation>
+) {
+	var start_ = len(v.parsed_) // The index of the first token in the rule.
+<Implementation>
+}
+`,
+		precedenceRuleMethod: `
+func (v *parser_) parse<Rule>() (
+	<rule_> ast.<Rule>Like,
+	token TokenLike,
+	ok bool,
+) {
+	// Start with the operators having the lowest level of precedence.
+	<rule_>, token, ok = v.parse<Rule>Level(1)
+	return <rule_>, token, ok
 }
+
+func (v *parser_) parse<Rule>Level(minimum uint) (
+	<rule_> ast.<Rule>Like,
+	token TokenLike,
+	ok bool,
+) {
+	var start_ = len(v.parsed_) // The index of the first token in the rule.
+<Implementation>
+	// Attempt to parse any operations having at least the minimum level.
+	var excluded uint // The level of a preceding non-associative operator.
+	for {
+		var operator string
+		operator, token, ok = v.parseToken(DelimiterToken)
+		if !ok {
+			operator, token, ok = v.parseToken(KeywordToken)
+		}
+		if !ok {
+			break
+		}
+		var level uint
+		var associativity string
+		switch v.foldDelimiter(operator) {<LevelCases>
+		}
+		if level < minimum {
+			// This operator belongs to an enclosing operation.
+			v.parsed_ = v.parsed_[:len(v.parsed_)-1]
+			v.putBack(token)
+			break
+		}
+		if level == excluded {
+			// Found a syntax error.
+			v.reportError(token, "<Rule>", "A non-associative operator cannot be chained.")
+		}
+
+		// Attempt to parse the right operand.
+		var next = level + 1
+		if associativity == ">" {
+			// A right associative operation groups from the right.
+			next = level
+		}
+		var right ast.<Rule>Like
+		right, token, ok = v.parse<Rule>Level(next)
+		if !ok {
+			// Found a syntax error.
+			v.reportError(token, "<Rule>", "")
+		}
+
+		// Found a single <rule> operation.
+		var span = v.getSpan(start_)
+		var operation = ast.<Rule>Operation().MakeWithSpan(span, <rule_>, operator, right)
+		<rule_> = ast.<Rule>().MakeWithSpan(span, operation)
+		excluded = 0
+		if associativity == "=" {
+			excluded = level
+		}
+	}
+	return <rule_>, token, true
+}
+`,
+		parseRuleOperand: `
+	// Attempt to parse a single <operandName> operand.
+	var <operandName_> ast.<OperandName>Like
+	<operandName_>, token, ok = v.parse<OperandName>()
+	if !ok {
+		// This is not a single <rule> rule.
+		return <rule_>, token, false
+	}
+	<rule_> = ast.<Rule>().MakeWithSpan(v.getSpan(start_), <operandName_>)
 `,
+		parseTokenOperand: `
+	// Attempt to parse a single <operandName> operand.
+	var <operandName_> string
+	<operandName_>, token, ok = v.parseToken(<OperandName>Token)
+	if !ok {
+		// This is not a single <rule> rule.
+		return <rule_>, token, false
+	}
+	<rule_> = ast.<Rule>().MakeWithSpan(v.getSpan(start_), <operandName_>)
+`,
+		operatorCase: `
+		case <Operators>:
+			level, associativity = <level>, "<associativity>"`,
 		parseDelimiter: `
-	// Attempt to parse a single "<delimiter>" delimiter.
-	_, token, ok = v.parseDelimiter("<delimiter>")
+	// Attempt to parse a single "<delimiter>" <kind>.
+	_, token, ok = v.parse<Kind>("<delimiter>")
 	if !ok {
 		if ruleFound_ {
 			// Found a syntax error.
-			var message = v.formatError(token,"<Rule>")
-			panic(message)
+			v.reportError(token, "<Rule>", "")
 		} else {
 			// This is not a single <rule> rule.
 			return <rule_>, token, false
@@ -494,13 +950,40 @@ func (v *parser_) parse<Rule>() (
 	}
 	ruleFound_ = true
 `,
+		parseLookahead: `
+	// Look ahead for the next term without consuming it.
+	token, ok = v.lookAhead(func() (token TokenLike, ok bool) {
+		_, token, ok = <lookaheadCall>
+		return token, ok
+	})
+	if !ok {
+		// This is not a single <rule> rule so put back any tokens parsed for it.
+		v.putBackTokens(start_)
+		return <rule_>, token, false
+	}
+`,
+		parseNegativeLookahead: `
+	// Look ahead to make sure the next term is not found.
+	token, ok = v.lookAhead(func() (token TokenLike, ok bool) {
+		_, token, ok = <lookaheadCall>
+		return token, ok
+	})
+	if ok {
+		// This is not a single <rule> rule so put back any tokens parsed for it.
+		v.putBackTokens(start_)
+		return <rule_>, token, false
+	}
+`,
+		lookaheadDelimiter: `v.parse<Kind>("<delimiter>")`,
+		lookaheadRule:      `v.parse<RuleName>()`,
+		lookaheadToken:     `v.parseToken(<TokenName>Token)`,
 		parseRuleCase: `
 	// Attempt to parse a single <ruleName> rule.
 	var <ruleName_> ast.<RuleName>Like
 	<ruleName_>, token, ok = v.parse<RuleName>()
 	if ok {
 		// Found a single <ruleName> <rule>.
-		<rule_> = ast.<Rule>().Make(<ruleName_>)
+		<rule_> = ast.<Rule>().MakeWithSpan(v.getSpan(start_), <ruleName_>)
 		return <rule_>, token, true
 	}
 `,
@@ -510,7 +993,7 @@ func (v *parser_) parse<Rule>() (
 	<tokenName_>, token, ok = v.parseToken(<TokenName>Token)
 	if ok {
 		// Found a single <tokenName> <rule>.
-		<rule_> = ast.<Rule>().Make(<tokenName_>)
+		<rule_> = ast.<Rule>().MakeWithSpan(v.getSpan(start_), <tokenName_>)
 		return <rule_>, token, true
 	}
 `,
@@ -520,7 +1003,7 @@ func (v *parser_) parse<Rule>() (
 	<ruleName_>, token, ok = v.parse<RuleName>()
 	if ok {
 		// Found a single <ruleName> <rule>.
-		<rule_> = ast.<Rule>().Make(<ruleName_>)
+		<rule_> = ast.<Rule>().MakeWithSpan(v.getSpan(start_), <ruleName_>)
 		return <rule_>, token, true
 	}
 `,
@@ -530,7 +1013,7 @@ func (v *parser_) parse<Rule>() (
 	<tokenName_>, token, ok = v.parse<TokenName>()
 	if ok {
 		// Found a single <tokenName> <rule>.
-		<rule_> = ast.<Rule>().Make(<tokenName_>)
+		<rule_> = ast.<Rule>().MakeWithSpan(v.getSpan(start_), <tokenName_>)
 		return <rule_>, token, true
 	}
 `,
@@ -541,8 +1024,7 @@ func (v *parser_) parse<Rule>() (
 	if !ok {
 		if ruleFound_ {
 			// Found a syntax error.
-			var message = v.formatError(token,"<Rule>")
-			panic(message)
+			v.reportError(token, "<Rule>", "")
 		} else {
 			// This is not a single <rule> rule.
 			return <rule_>, token, false
@@ -557,8 +1039,7 @@ func (v *parser_) parse<Rule>() (
 	if !ok {
 		if ruleFound_ {
 			// Found a syntax error.
-			var message = v.formatError(token,"<Rule>")
-			panic(message)
+			v.reportError(token, "<Rule>", "")
 		} else {
 			// This is not a single <rule> rule.
 			return <rule_>, token, false
@@ -576,6 +1057,7 @@ import (
 	abs "github.com/craterdog/go-collection-framework/v4/collection"
 	ast "<module>/ast"
 	sts "strings"
+	utf "unicode/utf8"
 )
 
 // CLASS ACCESS
@@ -585,7 +1067,7 @@ import (
 var parserClass = &parserClass_{
 	// Initialize the class constants.
 	queueSize_: 16,
-	stackSize_: 4,
+	stackSize_: unlimited, // Backtracking may put back every token in a rule.
 }
 
 // Function
@@ -619,10 +1101,12 @@ func (c *parserClass_) Make() ParserLike {
 
 type parser_ struct {
 	// Define the instance attributes.
-	class_     *parserClass_
-	source_    string                   // The original source code.
-	tokens_    abs.QueueLike[TokenLike] // A queue of unread tokens from the scanner.
-	next_      abs.StackLike[TokenLike] // A stack of read, but unprocessed tokens.
+	class_  *parserClass_
+	source_ string                       // The original source code.
+	tokens_ abs.QueueLike[TokenLike]     // A queue of unread tokens from the scanner.
+	next_   abs.StackLike[TokenLike]     // A stack of read, but unprocessed tokens.
+	errors_ abs.ListLike[ParseErrorLike] // A list of the syntax errors found.
+	parsed_ []TokenLike                  // The tokens that have been parsed so far.
 }
 
 // Public
@@ -632,22 +1116,42 @@ func (v *parser_) GetClass() ParserClassLike {
 }
 
 func (v *parser_) ParseSource(source string) ast.<SyntaxName>Like {
+	var <syntaxName>, errors = v.ParseSourceWithErrors(source)
+	if !errors.IsEmpty() {
+		// Report the first syntax error.
+		var error_ = errors.GetIterator().GetNext()
+		var message = v.formatError(error_)
+		panic(message)
+	}
+	return <syntaxName>
+}
+
+func (v *parser_) ParseSourceWithErrors(source string) (
+	<syntaxName> ast.<SyntaxName>Like,
+	errors abs.Sequential[ParseErrorLike],
+) {
 	v.source_ = source
 	v.tokens_ = col.Queue[TokenLike](parserClass.queueSize_)
 	v.next_ = col.Stack[TokenLike](parserClass.stackSize_)
+	v.errors_ = col.List[ParseErrorLike]()
+	v.parsed_ = nil
+	errors = v.errors_
+	defer v.recoverError() // Any syntax errors are added to the errors list.
 
 	// The scanner runs in a separate Go routine.
-	Scanner().Make(v.source_, v.tokens_)
+	var scanner = Scanner().Make(v.source_, v.tokens_)
+	defer scanner.Close() // The scanner must not outlive the parse.
 
 	// Attempt to parse the <syntaxName>.
-	var <syntaxName>, token, ok = v.parse<SyntaxName>()
+	var token TokenLike
+	var ok bool
+	<syntaxName>, token, ok = v.parse<SyntaxName>()
 	if !ok {
-		var message = v.formatError(token, "<SyntaxName>")
-		panic(message)
+		v.reportError(token, "<SyntaxName>", "")
 	}
 
 	// Found the <syntaxName>.
-	return <syntaxName>
+	return <syntaxName>, errors
 }
 
 // Private
@@ -660,10 +1164,11 @@ func (v *parser_) parseDelimiter(expectedValue string) (
 	// Attempt to parse a single delimiter.
 	value, token, ok = v.parseToken(DelimiterToken)
 	if ok {
-		if value == expectedValue {
+		if value == expectedValue || v.foldDelimiter(value) == expectedValue {
 			// Found the right delimiter.
 			return value, token, true
 		}
+		v.parsed_ = v.parsed_[:len(v.parsed_)-1]
 		v.putBack(token)
 	}
 
@@ -671,6 +1176,26 @@ func (v *parser_) parseDelimiter(expectedValue string) (
 	return value, token, false
 }
 
+func (v *parser_) parseKeyword(expectedValue string) (
+	value string,
+	token TokenLike,
+	ok bool,
+) {
+	// Attempt to parse a single keyword.
+	value, token, ok = v.parseToken(KeywordToken)
+	if ok {
+		if value == expectedValue || v.foldDelimiter(value) == expectedValue {
+			// Found the right keyword.
+			return value, token, true
+		}
+		v.parsed_ = v.parsed_[:len(v.parsed_)-1]
+		v.putBack(token)
+	}
+
+	// This is not the right keyword.
+	return value, token, false
+}
+
 func (v *parser_) parseToken(tokenType TokenType) (
 	value string,
 	token TokenLike,
@@ -684,10 +1209,8 @@ func (v *parser_) parseToken(tokenType TokenType) (
 		case tokenType:
 			// Found the right token type.
 			value = token.GetValue()
-			return value, token, true
-		case SpaceToken, NewlineToken:
-			// Ignore any unspecified whitespace.
-			token = v.getNextToken()
+			v.parsed_ = append(v.parsed_, token)
+			return value, token, true<IgnoredCases>
 		default:
 			// This is not the right token type.
 			v.putBack(token)
@@ -699,13 +1222,54 @@ func (v *parser_) parseToken(tokenType TokenType) (
 	return value, token, false
 }
 
-func (v *parser_) formatError(token TokenLike, ruleName string) string {
+func (v *parser_) attemptRecovery(ruleName string, parse func()) (recovered bool) {
+	var start = len(v.parsed_)
+	defer func() {
+		var result = recover()
+		if result == nil {
+			// No syntax error was found.
+			return
+		}
+		var error_, ok = result.(ParseErrorLike)
+		if !ok {
+			// This is not a syntax error so pass it on.
+			panic(result)
+		}
+		v.errors_.AppendValue(error_)
+		var token = error_.GetToken()
+		if len(v.parsed_) == start && token != nil && token.GetType() != ErrorToken {
+			// Skip over the token that could not be parsed so that parsing progresses.
+			v.readToken()
+		}
+		v.synchronize(ruleName)
+		recovered = true
+	}()
+	parse()
+	return recovered
+}
+
+func (v *parser_) foldDelimiter(value string) string {
+	// A caseless delimiter matches its value without regard to case.
+	var iterator = caselessDelimiters_.GetIterator()
+	for iterator.HasNext() {
+		var delimiter = iterator.GetNext()
+		if sts.EqualFold(value, delimiter) {
+			return delimiter
+		}
+	}
+	return value
+}
+
+func (v *parser_) formatError(error_ ParseErrorLike) string {
 	// Format the error message.
-	var message = fmt.Sprintf(
-		"An unexpected token was received by the parser: %v\n",
-		Scanner().FormatToken(token),
-	)
-	var line = token.GetLine()
+	var message = "An unexpected token was received by the parser: "
+	var token = error_.GetToken()
+	if token == nil {
+		message += "<EOF>\n"
+	} else {
+		message += Scanner().FormatToken(token) + "\n"
+	}
+	var line = error_.GetLine()
 	var lines = sts.Split(v.source_, "\n")
 
 	// Append the source line with the error in it.
@@ -718,7 +1282,7 @@ func (v *parser_) formatError(token TokenLike, ruleName string) string {
 	// Append an arrow pointing to the error.
 	message += " \033[32m>>>─"
 	var count uint
-	for count < token.GetPosition() {
+	for count < error_.GetPosition() {
 		message += "─"
 		count++
 	}
@@ -729,14 +1293,16 @@ func (v *parser_) formatError(token TokenLike, ruleName string) string {
 		message += fmt.Sprintf("%04d: ", line+1) + string(lines[line]) + "\n"
 	}
 	message += "\033[0m\n"
+	var ruleName = error_.GetRuleName()
 	if col.IsDefined(ruleName) {
 		message += "Was expecting:\n"
 		message += fmt.Sprintf(
 			"  \033[32m%v: \033[33m%v\033[0m\n\n",
 			ruleName,
-			v.getDefinition(ruleName),
+			error_.GetExpected(),
 		)
 	}
+	message += error_.GetMessage()
 	return message
 }
 
@@ -744,7 +1310,123 @@ func (v *parser_) getDefinition(ruleName string) string {
 	return syntax_.GetValue(ruleName)
 }
 
+func (v *parser_) getEnd(token TokenLike) ast.PositionLike {
+	// Find the text of the token in the source code.
+	var offset = token.GetOffset()
+	var text = token.GetValue()
+	if !sts.HasPrefix(v.source_[offset:], text) {
+		// The token value is the name of a single control character.
+		var _, size = utf.DecodeRuneInString(v.source_[offset:])
+		text = v.source_[offset : offset+uint(size)]
+	}
+
+	// The end position follows the last character of the token.
+	var line = token.GetLine()
+	var column = token.GetPosition()
+	var index = sts.LastIndex(text, "\n")
+	if index < 0 {
+		column += uint(utf.RuneCountInString(text))
+	} else {
+		line += uint(sts.Count(text, "\n"))
+		column = uint(utf.RuneCountInString(text[index+1:])) + 1
+	}
+	return ast.Position().Make(line, column, offset+uint(len(text)))
+}
+
 func (v *parser_) getNextToken() TokenLike {
+	// Read the next token.
+	var token = v.readToken()
+
+	// Check for an error token.
+	if token != nil && token.GetType() == ErrorToken {
+		var message = "An unrecognized character was found."<IndentationError>
+		v.reportError(token, "", message)
+	}
+
+	return token
+}
+
+func (v *parser_) getSpan(start int) ast.SpanLike {
+	// The span covers the tokens that were parsed for the node.
+	var end = len(v.parsed_) - 1
+	if end < start {
+		// No tokens were parsed for the node.
+		return nil
+	}
+	var span = ast.Span().Make(
+		v.getStart(v.parsed_[start]),
+		v.getEnd(v.parsed_[end]),
+	)
+	return span
+}
+
+func (v *parser_) getStart(token TokenLike) ast.PositionLike {
+	return ast.Position().Make(
+		token.GetLine(),
+		token.GetPosition(),
+		token.GetOffset(),
+	)
+}
+
+func (v *parser_) lookAhead(parse func() (TokenLike, bool)) (
+	token TokenLike,
+	ok bool,
+) {
+	// Put back every token that is parsed while looking ahead.
+	var start = len(v.parsed_)
+	defer func() {
+		var result = recover()
+		if result != nil {
+			var error_, isError = result.(ParseErrorLike)
+			if !isError {
+				// This is not a syntax error so pass it on.
+				panic(result)
+			}
+
+			// A syntax error means that the term was not found.
+			token = error_.GetToken()
+			if token != nil && token.GetType() == ErrorToken {
+				v.putBack(token) // It was not put back when it was reported.
+			}
+			ok = false
+		}
+		v.putBackTokens(start)
+	}()
+	token, ok = parse()
+	return token, ok
+}
+
+func (v *parser_) matchesAny(token TokenLike, names []string) bool {
+	// Each name is either a literal delimiter or the name of a token type.
+	var tokenType = token.GetType()
+	for _, name := range names {
+		switch tokenType {
+		case DelimiterToken, KeywordToken:
+			if name == "\""+v.foldDelimiter(token.GetValue())+"\"" {
+				return true
+			}
+		default:
+			if name == Scanner().FormatType(tokenType) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (v *parser_) putBack(token TokenLike) {
+	v.next_.AddValue(token)
+}
+
+func (v *parser_) putBackTokens(start int) {
+	// Put back every token that was parsed since the start index, last first.
+	for index := len(v.parsed_) - 1; index >= start; index-- {
+		v.putBack(v.parsed_[index])
+	}
+	v.parsed_ = v.parsed_[:start]
+}
+
+func (v *parser_) readToken() TokenLike {
 	// Check for any read, but unprocessed tokens.
 	if !v.next_.IsEmpty() {
 		return v.next_.RemoveTop()
@@ -757,17 +1439,65 @@ func (v *parser_) getNextToken() TokenLike {
 		return nil
 	}
 
-	// Check for an error token.
-	if token.GetType() == ErrorToken {
-		var message = v.formatError(token, "")
-		panic(message)
+	return token
+}
+
+func (v *parser_) recoverError() {
+	var result = recover()
+	if result == nil {
+		// No syntax error was found.
+		return
 	}
+	var error_, ok = result.(ParseErrorLike)
+	if !ok {
+		// This is not a syntax error so pass it on.
+		panic(result)
+	}
+	v.errors_.AppendValue(error_)
+}
 
-	return token
+func (v *parser_) reportError(token TokenLike, ruleName string, message string) {
+	// Locate the syntax error.
+	var line, position uint
+	if token != nil {
+		line = token.GetLine()
+		position = token.GetPosition()
+	} else {
+		// The end of the source was reached.
+		var lines = sts.Split(v.source_, "\n")
+		line = uint(len(lines))
+		position = uint(len([]rune(lines[line-1]))) + 1
+	}
+
+	// Abort the parsing with the syntax error.
+	var expected string
+	if col.IsDefined(ruleName) {
+		expected = v.getDefinition(ruleName)
+	}
+	var error_ = ParseError().Make(
+		line,
+		position,
+		token,
+		ruleName,
+		expected,
+		message,
+	)
+	panic(error_)
 }
 
-func (v *parser_) putBack(token TokenLike) {
-	v.next_.AddValue(token)
+func (v *parser_) synchronize(ruleName string) {
+	// Skip all tokens until one begins another rule or follows the rules.
+	var firsts = firsts_.GetValue(ruleName)
+	var follows = follows_.GetValue(ruleName)
+	var token = v.readToken()
+	for token != nil {
+		if v.matchesAny(token, firsts) || v.matchesAny(token, follows) {
+			// Resume parsing with this token, any new syntax error is reported.
+			v.putBack(token)
+			return
+		}
+		token = v.readToken()
+	}
 }
 
 // PRIVATE GLOBALS
@@ -776,10 +1506,24 @@ func (v *parser_) putBack(token TokenLike) {
 
 const unlimited = 4294967295 // Default to a reasonable value.
 
+var caselessDelimiters_ = col.Set[string]([]string{<CaselessDelimiters>})
+
 var syntax_ = col.Catalog[string, string](
 	map[string]string{<SyntaxMap>
 	},
 )
+
+// The tokens that may begin each rule that the parser recovers from.
+var firsts_ = col.Catalog[string, []string](
+	map[string][]string{<Firsts>
+	},
+)
+
+// The tokens that may follow each rule that the parser recovers from.
+var follows_ = col.Catalog[string, []string](
+	map[string][]string{<Follows>
+	},
+)
 `,
 	},
 )
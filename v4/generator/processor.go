@@ -42,10 +42,15 @@ type processorClass_ struct {
 // Constructors
 
 func (c *processorClass_) Make() ProcessorLike {
+	return c.MakeWithTemplates(Templates().Make())
+}
+
+func (c *processorClass_) MakeWithTemplates(templates TemplatesLike) ProcessorLike {
 	var processor = &processor_{
 		// Initialize the instance attributes.
-		class_:    c,
-		analyzer_: Analyzer().Make(),
+		class_:     c,
+		analyzer_:  Analyzer().Make(),
+		templates_: templates,
 	}
 	return processor
 }
@@ -56,8 +61,9 @@ func (c *processorClass_) Make() ProcessorLike {
 
 type processor_ struct {
 	// Define the instance attributes.
-	class_    *processorClass_
-	analyzer_ AnalyzerLike
+	class_     *processorClass_
+	analyzer_  AnalyzerLike
+	templates_ TemplatesLike
 }
 
 // Public
@@ -83,6 +89,7 @@ func (v *processor_) GenerateProcessorClass(
 	implementation = replaceAll(implementation, "ruleProcessors", ruleProcessors)
 	var name = v.analyzer_.GetSyntaxName()
 	implementation = replaceAll(implementation, "name", name)
+	implementation = removeUnusedImports(implementation)
 	return implementation
 }
 
@@ -111,7 +118,7 @@ func (v *processor_) generateTokenProcessors() string {
 	var iterator = v.analyzer_.GetTokenNames().GetIterator()
 	for iterator.HasNext() {
 		var tokenName = iterator.GetNext()
-		if tokenName == "delimiter" {
+		if tokenName == "delimiter" || tokenName == "keyword" {
 			continue
 		}
 		var isPlural = v.analyzer_.IsPlural(tokenName)
@@ -128,7 +135,7 @@ func (v *processor_) generateTokenProcessors() string {
 }
 
 func (v *processor_) getTemplate(name string) string {
-	var template = processorTemplates_.GetValue(name)
+	var template = v.templates_.GetTemplate("processor/" + name)
 	return template
 }
 
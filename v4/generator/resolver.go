@@ -0,0 +1,365 @@
+/*
+................................................................................
+.    Copyright (c) 2009-2024 Crater Dog Technologies.  All Rights Reserved.    .
+................................................................................
+.  DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               .
+.                                                                              .
+.  This code is free software; you can redistribute it and/or modify it under  .
+.  the terms of The MIT License (MIT), as published by the Open Source         .
+.  Initiative. (See https://opensource.org/license/MIT)                        .
+................................................................................
+*/
+package generator
+
+import (
+	fmt "fmt"
+	col "github.com/craterdog/go-collection-framework/v4"
+	abs "github.com/craterdog/go-collection-framework/v4/collection"
+	ast "github.com/craterdog/go-grammar-framework/v4/ast"
+	gra "github.com/craterdog/go-grammar-framework/v4/grammar"
+	osx "os"
+	fil "path/filepath"
+	stc "strconv"
+	sts "strings"
+)
+
+// CLASS ACCESS
+
+// Reference
+
+var resolverClass = &resolverClass_{
+	// Initialize the class constants.
+}
+
+// Function
+
+func Resolver() ResolverClassLike {
+	return resolverClass
+}
+
+// CLASS METHODS
+
+// Target
+
+type resolverClass_ struct {
+	// Define the class constants.
+}
+
+// Constructors
+
+func (c *resolverClass_) Make(directories abs.Sequential[string]) ResolverLike {
+	if col.IsUndefined(directories) {
+		panic("The directories attribute is required by this class.")
+	}
+	var resolver = &resolver_{
+		// Initialize the instance attributes.
+		class_:       c,
+		directories_: directories,
+	}
+	return resolver
+}
+
+// INSTANCE METHODS
+
+// Target
+
+type resolver_ struct {
+	// Define the instance attributes.
+	class_       *resolverClass_
+	directories_ abs.Sequential[string]
+	root_        string                          // The path of the importing syntax.
+	origins_     abs.CatalogLike[string, string] // Describes the file that defines each name.
+	resolved_    abs.SetLike[string]             // The files merged so far.
+	importing_   abs.ListLike[string]            // The chain of files being imported.
+	rules_       abs.ListLike[ast.RuleLike]
+	expressions_ abs.ListLike[ast.ExpressionLike]
+	modes_       abs.CatalogLike[string, ast.ModeLike]                     // The first definition of each mode.
+	modeLists_   abs.CatalogLike[string, abs.ListLike[ast.ExpressionLike]] // The merged expressions of each mode.
+	problems_    abs.ListLike[string]
+}
+
+// Public
+
+func (v *resolver_) GetClass() ResolverClassLike {
+	return v.class_
+}
+
+func (v *resolver_) GetDirectories() abs.Sequential[string] {
+	return v.directories_
+}
+
+func (v *resolver_) ResolveSyntax(syntax ast.SyntaxLike) ast.SyntaxLike {
+	return v.resolveSyntax("", syntax)
+}
+
+func (v *resolver_) ResolveSyntaxFile(
+	path string,
+	syntax ast.SyntaxLike,
+) ast.SyntaxLike {
+	return v.resolveSyntax(v.makeAbsolute(path), syntax)
+}
+
+// Private
+
+func (v *resolver_) resolveSyntax(
+	path string,
+	syntax ast.SyntaxLike,
+) ast.SyntaxLike {
+	v.root_ = path
+	v.origins_ = col.Catalog[string, string]()
+	v.resolved_ = col.Set[string]()
+	v.importing_ = col.List[string]()
+	v.rules_ = col.List[ast.RuleLike]()
+	v.expressions_ = col.List[ast.ExpressionLike]()
+	v.modes_ = col.Catalog[string, ast.ModeLike]()
+	v.modeLists_ = col.Catalog[string, abs.ListLike[ast.ExpressionLike]]()
+	v.problems_ = col.List[string]()
+
+	// The definitions in the importing syntax come before any imported ones.
+	// Its path, when known, starts the chain of files being imported so that
+	// an import cycle back to it is reported.
+	if len(path) > 0 {
+		v.resolved_.AddValue(path)
+		v.importing_.AppendValue(path)
+	}
+	v.mergeSyntax(path, syntax)
+	if !v.problems_.IsEmpty() {
+		var message = "The imports contain the following problems:"
+		var problems = v.problems_.GetIterator()
+		for problems.HasNext() {
+			message += "\n  " + problems.GetNext()
+		}
+		panic(message)
+	}
+
+	// Modes with the same name in different files are merged into one.
+	var modes = col.List[ast.ModeLike]()
+	var iterator = v.modes_.GetIterator()
+	for iterator.HasNext() {
+		var mode = iterator.GetNext().GetValue()
+		var name = mode.GetLowercase()
+		modes.AppendValue(ast.Mode().Make(
+			name,
+			mode.GetOptionalNote(),
+			mode.GetNewlines(),
+			v.modeLists_.GetValue(name),
+		))
+	}
+
+	// The resolved syntax no longer has any imports.  Only the scanner options
+	// of the importing syntax apply.
+	var resolved = ast.Syntax().MakeWithSpan(
+		syntax.GetSpan(),
+		syntax.GetNotice(),
+		syntax.GetDirectives(),
+		col.List[ast.ImportLike](),
+		syntax.GetRuleHeader(),
+		syntax.GetOptionalNewline(),
+		v.rules_,
+		syntax.GetExpressionHeader(),
+		v.expressions_,
+		modes,
+	)
+	return resolved
+}
+
+func (v *resolver_) findFile(filename string) string {
+	var directories = v.directories_.GetIterator()
+	for directories.HasNext() {
+		var path = fil.Join(directories.GetNext(), filename)
+		var info, err = osx.Stat(path)
+		if err == nil && !info.IsDir() {
+			return v.makeAbsolute(path)
+		}
+	}
+	return ""
+}
+
+func (v *resolver_) describeFile(path string) string {
+	if len(path) == 0 {
+		return "the importing syntax"
+	}
+	return fmt.Sprintf("%q", path)
+}
+
+func (v *resolver_) importSyntax(origin string, import_ ast.ImportLike) {
+	var filename, err = stc.Unquote(import_.GetLiteral()) // Remove the double quotes.
+	if err != nil {
+		panic(err)
+	}
+	var path = v.findFile(filename)
+	if len(path) == 0 {
+		var message = fmt.Sprintf(
+			"The syntax file %q imported by %v was not found on the search path.",
+			filename,
+			v.describeFile(origin),
+		)
+		v.reportProblem(origin, import_.GetSpan(), message)
+		return
+	}
+	if v.importing_.GetIndex(path) > 0 {
+		var cycle = append(v.importing_.AsArray(), path)
+		var message = fmt.Sprintf(
+			"The syntax file %q imports itself: %v",
+			path,
+			sts.Join(cycle, " -> "),
+		)
+		v.reportProblem(origin, import_.GetSpan(), message)
+		return
+	}
+	if v.resolved_.ContainsValue(path) {
+		// Each syntax file is only merged once.
+		return
+	}
+	v.resolved_.AddValue(path)
+	var bytes []byte
+	bytes, err = osx.ReadFile(path)
+	if err != nil {
+		var message = fmt.Sprintf(
+			"The syntax file %q could not be read: %v",
+			path,
+			err,
+		)
+		v.reportProblem(origin, import_.GetSpan(), message)
+		return
+	}
+	var parser = gra.Parser().Make()
+	var syntax, errors = parser.ParseSourceWithErrors(string(bytes))
+	if !errors.IsEmpty() {
+		var iterator = errors.GetIterator()
+		for iterator.HasNext() {
+			var error_ = iterator.GetNext()
+			v.problems_.AppendValue(path + ":" + error_.Error())
+		}
+		return
+	}
+	v.importing_.AppendValue(path)
+	v.mergeSyntax(path, syntax)
+	v.importing_.RemoveValue(v.importing_.GetSize())
+}
+
+func (v *resolver_) makeAbsolute(path string) string {
+	// Each file is identified by its absolute path so that it is recognized
+	// however it is reached.
+	var absolute, err = fil.Abs(path)
+	if err != nil {
+		panic(err)
+	}
+	return absolute
+}
+
+func (v *resolver_) mergeDefinition(
+	name string,
+	origin string,
+	span ast.SpanLike,
+) bool {
+	var previous = v.origins_.GetValue(name)
+	if len(previous) > 0 {
+		var kind = "expression"
+		if gra.Scanner().MatchesType(name, gra.UppercaseToken) {
+			kind = "rule"
+		}
+		var message = fmt.Sprintf(
+			"The %v %q defined in %v clashes with the one defined in %v.",
+			kind,
+			name,
+			v.describeFile(origin),
+			previous,
+		)
+		v.reportProblem(origin, span, message)
+		return false
+	}
+	v.origins_.SetValue(name, v.describeFile(origin))
+	return true
+}
+
+func (v *resolver_) mergeSyntax(origin string, syntax ast.SyntaxLike) {
+	var rules = syntax.GetRules().GetIterator()
+	for rules.HasNext() {
+		var rule = rules.GetNext()
+		if v.mergeDefinition(rule.GetUppercase(), origin, rule.GetSpan()) {
+			v.rules_.AppendValue(v.relocateRule(origin, rule))
+		}
+	}
+	var expressions = syntax.GetExpressions().GetIterator()
+	for expressions.HasNext() {
+		var expression = expressions.GetNext()
+		if v.mergeDefinition(expression.GetLowercase(), origin, expression.GetSpan()) {
+			v.expressions_.AppendValue(v.relocateExpression(origin, expression))
+		}
+	}
+	var modes = syntax.GetModes().GetIterator()
+	for modes.HasNext() {
+		var mode = modes.GetNext()
+		var name = mode.GetLowercase()
+		var list = v.modeLists_.GetValue(name)
+		if col.IsUndefined(list) {
+			list = col.List[ast.ExpressionLike]()
+			v.modes_.SetValue(name, mode)
+			v.modeLists_.SetValue(name, list)
+		}
+		expressions = mode.GetExpressions().GetIterator()
+		for expressions.HasNext() {
+			var expression = expressions.GetNext()
+			if v.mergeDefinition(expression.GetLowercase(), origin, expression.GetSpan()) {
+				list.AppendValue(v.relocateExpression(origin, expression))
+			}
+		}
+	}
+	var imports = syntax.GetImports().GetIterator()
+	for imports.HasNext() {
+		v.importSyntax(origin, imports.GetNext())
+	}
+}
+
+func (v *resolver_) relocateExpression(
+	origin string,
+	expression ast.ExpressionLike,
+) ast.ExpressionLike {
+	// The span of an imported definition names the file that defines it.
+	var span = expression.GetSpan()
+	if origin == v.root_ || col.IsUndefined(span) {
+		return expression
+	}
+	return ast.Expression().MakeWithSpan(
+		ast.Span().MakeWithSource(origin, span.GetStart(), span.GetEnd()),
+		expression.GetLowercase(),
+		expression.GetOptionalCaseless(),
+		expression.GetPattern(),
+		expression.GetOptionalTransition(),
+		expression.GetOptionalNote(),
+		expression.GetNewlines(),
+	)
+}
+
+func (v *resolver_) relocateRule(
+	origin string,
+	rule ast.RuleLike,
+) ast.RuleLike {
+	var span = rule.GetSpan()
+	if origin == v.root_ || col.IsUndefined(span) {
+		return rule
+	}
+	return ast.Rule().MakeWithSpan(
+		ast.Span().MakeWithSource(origin, span.GetStart(), span.GetEnd()),
+		rule.GetUppercase(),
+		rule.GetDefinition(),
+		rule.GetNewlines(),
+	)
+}
+
+func (v *resolver_) reportProblem(
+	path string,
+	span ast.SpanLike,
+	message string,
+) {
+	if col.IsDefined(span) {
+		var start = span.GetStart()
+		message = fmt.Sprintf("%d:%d: ", start.GetLine(), start.GetColumn()) + message
+	}
+	if len(path) > 0 {
+		// Positions within an imported file are prefixed with its path.
+		message = path + ":" + message
+	}
+	v.problems_.AppendValue(message)
+}
@@ -42,10 +42,15 @@ type scannerClass_ struct {
 // Constructors
 
 func (c *scannerClass_) Make() ScannerLike {
+	return c.MakeWithTemplates(Templates().Make())
+}
+
+func (c *scannerClass_) MakeWithTemplates(templates TemplatesLike) ScannerLike {
 	var scanner = &scanner_{
 		// Initialize the instance attributes.
-		class_:    c,
-		analyzer_: Analyzer().Make(),
+		class_:     c,
+		analyzer_:  Analyzer().Make(),
+		templates_: templates,
 	}
 	return scanner
 }
@@ -56,8 +61,9 @@ func (c *scannerClass_) Make() ScannerLike {
 
 type scanner_ struct {
 	// Define the instance attributes.
-	class_    *scannerClass_
-	analyzer_ AnalyzerLike
+	class_     *scannerClass_
+	analyzer_  AnalyzerLike
+	templates_ TemplatesLike
 }
 
 // Public
@@ -82,8 +88,38 @@ func (v *scanner_) GenerateScannerClass(
 	implementation = replaceAll(implementation, "tokenMatchers", tokenMatchers)
 	var foundCases = v.generateFoundCases()
 	implementation = replaceAll(implementation, "foundCases", foundCases)
+	var keywordCheck string
+	if v.analyzer_.HasKeywords() {
+		keywordCheck = v.getTemplate(keywordCheckTemplate)
+	}
+	implementation = replaceAll(implementation, "keywordCheck", keywordCheck)
+	var indentationAttributes, indentationMethod, remainingDedents string
+	if v.analyzer_.IsIndented() {
+		indentationAttributes = v.getTemplate(indentationAttributesTemplate)
+		indentationMethod = v.getTemplate(indentationMethodTemplate)
+		remainingDedents = v.getTemplate(remainingDedentsTemplate)
+	}
+	implementation = replaceAll(implementation, "indentationAttributes", indentationAttributes)
+	implementation = replaceAll(implementation, "indentationMethod", indentationMethod)
+	implementation = replaceAll(implementation, "remainingDedents", remainingDedents)
+	var modeAttributes, modeMethod, transitionCall, transitionMethod string
+	if !v.analyzer_.GetModes().IsEmpty() {
+		modeAttributes = v.getTemplate(modeAttributesTemplate)
+		modeMethod = v.getTemplate(modeMethodTemplate)
+		var modeCases = v.generateModeCases()
+		modeMethod = replaceAll(modeMethod, "modeCases", modeCases)
+		transitionCall = v.getTemplate(transitionCallTemplate)
+		transitionMethod = v.getTemplate(transitionMethodTemplate)
+		var transitionCases = v.generateTransitionCases()
+		transitionMethod = replaceAll(transitionMethod, "transitionCases", transitionCases)
+	}
+	implementation = replaceAll(implementation, "modeAttributes", modeAttributes)
+	implementation = replaceAll(implementation, "modeMethod", modeMethod)
+	implementation = replaceAll(implementation, "transitionCall", transitionCall)
+	implementation = replaceAll(implementation, "transitionMethod", transitionMethod)
 	var expressions = v.generateExpressions()
 	implementation = replaceAll(implementation, "expressions", expressions)
+	implementation = removeUnusedImports(implementation)
 	return implementation
 }
 
@@ -103,20 +139,76 @@ func (v *scanner_) generateExpressions() string {
 
 func (v *scanner_) generateFoundCases() string {
 	var foundCases = "// Find the next token type."
+	if v.analyzer_.IsIndented() {
+		// The indentation is checked before any tokens on each line.
+		foundCases += "\n\t\tcase v.foundIndentation():"
+	}
+	if !v.analyzer_.GetModes().IsEmpty() {
+		// The named modes scan their own tokens.
+		foundCases += "\n\t\tcase v.foundMode():"
+	}
 	var iterator = v.analyzer_.GetTokenNames().GetIterator()
 	for iterator.HasNext() {
 		var tokenName = iterator.GetNext()
+		if tokenName == "dedent" || tokenName == "indent" {
+			// The indentation tokens are never matched directly.
+			continue
+		}
+		if len(v.analyzer_.GetMode(tokenName)) > 0 {
+			continue
+		}
+		if tokenName == "keyword" && !v.analyzer_.HasKeywords() {
+			// There are no keywords to be scanned.
+			continue
+		}
 		var tokenType = makeUpperCase(tokenName) + "Token"
 		foundCases += "\n\t\tcase v.foundToken(" + tokenType + "):"
 	}
 	return foundCases
 }
 
+func (v *scanner_) generateModeCases() string {
+	var modeCases string
+	var modes = v.analyzer_.GetModes().GetIterator()
+	for modes.HasNext() {
+		var mode = modes.GetNext()
+		modeCases += "\n\tcase \"" + mode + "\":\n\t\tswitch {"
+		var common string
+		var iterator = v.analyzer_.GetTokenNames().GetIterator()
+		for iterator.HasNext() {
+			var tokenName = iterator.GetNext()
+			var tokenType = makeUpperCase(tokenName) + "Token"
+			switch tokenName {
+			case "keyword":
+				if v.analyzer_.HasKeywords() {
+					common += "\n\t\tcase v.foundToken(" + tokenType + "):"
+				}
+			case "delimiter", "newline", "space":
+				// The common tokens are tried after the tokens of the mode.
+				common += "\n\t\tcase v.foundToken(" + tokenType + "):"
+			default:
+				if v.analyzer_.GetMode(tokenName) == mode {
+					modeCases += "\n\t\tcase v.foundToken(" + tokenType + "):"
+				}
+			}
+		}
+		modeCases += common
+		modeCases += "\n\t\tdefault:"
+		modeCases += "\n\t\t\t// Skip the unrecognized character and keep scanning."
+		modeCases += "\n\t\t\tv.foundError()"
+		modeCases += "\n\t\t}"
+	}
+	return modeCases
+}
+
 func (v *scanner_) generateTokenMatchers() string {
 	var tokenMatchers = "// Define pattern matchers for each type of token."
 	var iterator = v.analyzer_.GetTokenNames().GetIterator()
 	for iterator.HasNext() {
 		var tokenName = iterator.GetNext()
+		if tokenName == "keyword" && !v.analyzer_.HasKeywords() {
+			continue
+		}
 		var tokenType = makeUpperCase(tokenName) + "Token"
 		tokenMatchers += "\n\t\t" + tokenType +
 			`: reg.MustCompile("^" + ` + tokenName + `_),`
@@ -135,8 +227,31 @@ func (v *scanner_) generateTokenNames() string {
 	return tokenNames
 }
 
+func (v *scanner_) generateTransitionCases() string {
+	var transitionCases string
+	var iterator = v.analyzer_.GetTokenNames().GetIterator()
+	for iterator.HasNext() {
+		var tokenName = iterator.GetNext()
+		var transition = v.analyzer_.GetTransition(tokenName)
+		if col.IsUndefined(transition) {
+			continue
+		}
+		var tokenType = makeUpperCase(tokenName) + "Token"
+		transitionCases += "\n\tcase " + tokenType + ":"
+		var mode = transition.GetOptionalMode()
+		if len(mode) > 0 {
+			transitionCases += "\n\t\tv.modes_ = append(v.modes_, \"" + mode + "\")"
+		} else {
+			transitionCases += "\n\t\tif len(v.modes_) > 0 {"
+			transitionCases += "\n\t\t\tv.modes_ = v.modes_[:len(v.modes_)-1]"
+			transitionCases += "\n\t\t}"
+		}
+	}
+	return transitionCases
+}
+
 func (v *scanner_) getTemplate(name string) string {
-	var template = scannerTemplates_.GetValue(name)
+	var template = v.templates_.GetTemplate("scanner/" + name)
 	return template
 }
 
@@ -144,8 +259,112 @@ func (v *scanner_) getTemplate(name string) string {
 
 // Constants
 
+const (
+	indentationAttributesTemplate = "indentationAttributesTemplate"
+	indentationMethodTemplate     = "indentationMethodTemplate"
+	keywordCheckTemplate          = "keywordCheckTemplate"
+	modeAttributesTemplate        = "modeAttributesTemplate"
+	modeMethodTemplate            = "modeMethodTemplate"
+	remainingDedentsTemplate      = "remainingDedentsTemplate"
+	transitionCallTemplate        = "transitionCallTemplate"
+	transitionMethodTemplate      = "transitionMethodTemplate"
+)
+
 var scannerTemplates_ = col.Catalog[string, string](
 	map[string]string{
+		indentationAttributesTemplate: `
+	indents_  []string // The stack of indentations enclosing the current line.
+	indented_ uint     // The last line whose indentation has been checked.`,
+		indentationMethodTemplate: `
+func (v *scanner_) foundIndentation() bool {
+	// The indentation is only checked at the start of each line.
+	if v.position_ > 1 || v.line_ == v.indented_ {
+		return false
+	}
+	v.indented_ = v.line_
+	if len(v.indents_) == 0 {
+		v.indents_ = []string{""} // The outermost lines are not indented.
+	}
+
+	// Blank lines do not affect the indentation.
+	var text = v.source_[v.next_:] // Slicing a string does not copy it.
+	var remaining = sts.TrimLeft(text, " \t")
+	if len(remaining) == 0 || remaining[0] == '\r' || remaining[0] == '\n' {
+		return false
+	}
+	var indentation = text[:len(text)-len(remaining)]
+
+	// A deeper indentation opens a new block.
+	var enclosing = v.indents_[len(v.indents_)-1]
+	if len(indentation) > len(enclosing) && sts.HasPrefix(indentation, enclosing) {
+		v.indents_ = append(v.indents_, indentation)
+		v.emitIndentation(IndentToken)
+		return true
+	}
+
+	// A shallower indentation closes each block that is indented further.
+	var found bool
+	for len(v.indents_) > 1 && len(indentation) < len(enclosing) {
+		v.indents_ = v.indents_[:len(v.indents_)-1]
+		enclosing = v.indents_[len(v.indents_)-1]
+		v.emitIndentation(DedentToken)
+		found = true
+	}
+	if indentation != enclosing {
+		// The indentation does not match any enclosing indentation, so it is
+		// scanned as an error token containing only the offending whitespace.
+		v.next_ += uint(len(indentation))
+		var token = Token().MakeWithOffset(v.line_, v.position_, v.first_, ErrorToken, indentation)
+		//fmt.Println(Scanner().FormatToken(token)) // Uncomment when debugging.
+		v.tokens_.AddValue(token) // This will block if the queue is full.
+		v.position_ += uint(len(indentation))
+		v.first_ = v.next_
+		found = true
+	}
+	return found
+}
+
+func (v *scanner_) emitIndentation(tokenType TokenType) {
+	// The indentation tokens do not consume any characters, so each is given a
+	// canonical value instead of an empty one.
+	var value = "<INDENT>"
+	if tokenType == DedentToken {
+		value = "<DEDENT>"
+	}
+	var token = Token().MakeWithOffset(v.line_, v.position_, v.first_, tokenType, value)
+	//fmt.Println(Scanner().FormatToken(token)) // Uncomment when debugging.
+	v.tokens_.AddValue(token) // This will block if the queue is full.
+}
+`,
+		keywordCheckTemplate: `
+
+	// Reserved keywords are never scanned as any other token type.
+	var keyword = scannerClass.matchers_[KeywordToken]
+	if tokenType != KeywordToken && keyword.FindString(match) == match {
+		tokenType = KeywordToken
+	}`,
+		modeAttributesTemplate: `
+	modes_    []string // The stack of named modes, which is empty in the default mode.`,
+		modeMethodTemplate: `
+func (v *scanner_) foundMode() bool {
+	if len(v.modes_) == 0 {
+		// The default mode is scanned by the caller.
+		return false
+	}
+
+	// The tokens of a named mode are tried before the common tokens.
+	switch v.modes_[len(v.modes_)-1] {<ModeCases>
+	}
+	return true
+}
+`,
+		remainingDedentsTemplate: `
+
+	// Close any blocks that are still open at the end of the source.
+	for len(v.indents_) > 1 {
+		v.indents_ = v.indents_[:len(v.indents_)-1]
+		v.emitIndentation(DedentToken)
+	}`,
 		classTemplate: `<Notice>
 
 package grammar
@@ -155,7 +374,9 @@ import (
 	abs "github.com/craterdog/go-collection-framework/v4/collection"
 	reg "regexp"
 	sts "strings"
+	syn "sync"
 	uni "unicode"
+	utf "unicode/utf8"
 )
 
 // CLASS ACCESS
@@ -199,8 +420,9 @@ func (c *scannerClass_) Make(
 		class_:    c,
 		line_:     1,
 		position_: 1,
-		runes_:    []rune(source),
+		source_:   source,
 		tokens_:   tokens,
+		closed_:   make(chan bool),
 	}
 	go scanner.scanTokens() // Start scanning tokens in the background.
 	return scanner
@@ -243,12 +465,14 @@ func (c *scannerClass_) MatchesType(
 type scanner_ struct {
 	// Define the instance attributes.
 	class_    *scannerClass_
-	first_    uint // A zero based index of the first possible rune in the next token.
-	next_     uint // A zero based index of the next possible rune in the next token.
+	first_    uint // A zero based index of the first possible byte in the next token.
+	next_     uint // A zero based index of the next possible byte in the next token.
 	line_     uint // The line number in the source string of the next rune.
 	position_ uint // The position in the current line of the next rune.
-	runes_    []rune
+	source_   string
 	tokens_   abs.QueueLike[TokenLike]
+	closing_  syn.Once  // This ensures that the scanner is only closed once.
+	closed_   chan bool // This is closed when the scanner should stop scanning.<IndentationAttributes><ModeAttributes>
 }
 
 // Public
@@ -257,6 +481,20 @@ func (v *scanner_) GetClass() ScannerClassLike {
 	return v.class_
 }
 
+func (v *scanner_) Close() {
+	// Only the first call closes the scanner, even when called concurrently.
+	v.closing_.Do(func() {
+		// Tell the background scanner to stop scanning.
+		close(v.closed_)
+
+		// Drain any unread tokens so that the background scanner is not blocked.
+		var ok = true
+		for ok {
+			_, ok = v.tokens_.RemoveHead() // This will wait for a token.
+		}
+	})
+}
+
 // Private
 
 /*
@@ -273,14 +511,18 @@ const (
 	control_ = "\\p{Cc}"
 	digit_   = "\\p{Nd}"
 	eol_     = "\\r?\\n"
+	letter_  = "\\p{L}"
 	lower_   = "\\p{Ll}"
+	punct_   = "\\p{P}"
+	symbol_  = "\\p{S}"
 	upper_   = "\\p{Lu}"
+	white_   = "\\t-\\r\\x85\\p{Z}" // This must be used within a character class.
 
 	<Expressions>
 )
 
 func (v *scanner_) emitToken(tokenType TokenType) {
-	var value = string(v.runes_[v.first_:v.next_])
+	var value = v.source_[v.first_:v.next_]
 	switch value {
 	case "\x00":
 		value = "<NULL>"
@@ -297,36 +539,39 @@ func (v *scanner_) emitToken(tokenType TokenType) {
 	case "\v":
 		value = "<VTAB>"
 	}
-	var token = Token().Make(v.line_, v.position_, tokenType, value)
+	var token = Token().MakeWithOffset(v.line_, v.position_, v.first_, tokenType, value)
 	//fmt.Println(Scanner().FormatToken(token)) // Uncomment when debugging.
 	v.tokens_.AddValue(token) // This will block if the queue is full.
 }
 
 func (v *scanner_) foundError() {
-	v.next_++
+	var _, size = utf.DecodeRuneInString(v.source_[v.next_:])
+	v.next_ += uint(size)
 	v.emitToken(ErrorToken)
+	v.position_++
+	v.first_ = v.next_
 }
-
+<IndentationMethod><ModeMethod>
 func (v *scanner_) foundToken(tokenType TokenType) bool {
 	// Attempt to match the specified token type.
-	var text = string(v.runes_[v.next_:])
+	var text = v.source_[v.next_:] // Slicing a string does not copy it.
 	var matcher = scannerClass.matchers_[tokenType]
 	var match = matcher.FindString(text)
 	if len(match) == 0 {
 		return false
 	}
 
-	// Check for false delimiter matches.
-	var token = []rune(match)
-	var length = uint(len(token))
-	var previous = token[length-1]
-	if tokenType == DelimiterToken && uint(len(v.runes_)) > v.next_+length {
-		var next = v.runes_[v.next_+length]
+	// Check for false delimiter and keyword matches.
+	var length = uint(len(match))
+	var isDelimiter = tokenType == DelimiterToken || tokenType == KeywordToken
+	if isDelimiter && uint(len(v.source_)) > v.next_+length {
+		var previous, _ = utf.DecodeLastRuneInString(match)
+		var next, _ = utf.DecodeRuneInString(v.source_[v.next_+length:])
 		if (uni.IsLetter(previous) || uni.IsNumber(previous)) &&
 			(uni.IsLetter(next) || uni.IsNumber(next) || next == '_') {
 			return false
 		}
-	}
+	}<KeywordCheck>
 
 	// Found the requested token type.
 	v.next_ += length
@@ -334,36 +579,51 @@ func (v *scanner_) foundToken(tokenType TokenType) bool {
 	var count = uint(sts.Count(match, "\n"))
 	if count > 0 {
 		v.line_ += count
-		v.position_ = v.indexOfLastEol(token)
+		v.position_ = v.indexOfLastEol(match)
 	} else {
-		v.position_ += v.next_ - v.first_
+		v.position_ += uint(utf.RuneCountInString(match))
 	}
-	v.first_ = v.next_
+	v.first_ = v.next_<TransitionCall>
 	return true
 }
 
-func (v *scanner_) indexOfLastEol(runes []rune) uint {
-	var length = uint(len(runes))
-	for index := length; index > 0; index-- {
-		if runes[index-1] == '\n' {
-			return length - index + 1
-		}
+func (v *scanner_) indexOfLastEol(match string) uint {
+	var index = sts.LastIndex(match, "\n")
+	if index < 0 {
+		return 0
+	}
+	return uint(utf.RuneCountInString(match[index+1:])) + 1
+}
+
+func (v *scanner_) isClosed() bool {
+	select {
+	case <-v.closed_:
+		return true
+	default:
+		return false
 	}
-	return 0
 }
 
 func (v *scanner_) scanTokens() {
-loop:
-	for v.next_ < uint(len(v.runes_)) {
+	for v.next_ < uint(len(v.source_)) && !v.isClosed() {
 		switch {
 		<FoundCases>
 		default:
+			// Skip the unrecognized character and keep scanning.
 			v.foundError()
-			break loop
 		}
-	}
+	}<RemainingDedents>
 	v.tokens_.CloseQueue()
 }
+<TransitionMethod>`,
+		transitionCallTemplate: `
+	v.switchMode(tokenType)`,
+		transitionMethodTemplate: `
+func (v *scanner_) switchMode(tokenType TokenType) {
+	// Some tokens push a named mode or return to the enclosing mode.
+	switch tokenType {<TransitionCases>
+	}
+}
 `,
 	},
 )
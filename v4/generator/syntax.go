@@ -20,6 +20,7 @@ import (
 	abs "github.com/craterdog/go-collection-framework/v4/collection"
 	ast "github.com/craterdog/go-grammar-framework/v4/ast"
 	gra "github.com/craterdog/go-grammar-framework/v4/grammar"
+	reg "regexp"
 	stc "strconv"
 	sts "strings"
 	tim "time"
@@ -49,9 +50,14 @@ type syntaxClass_ struct {
 // Constructors
 
 func (c *syntaxClass_) Make() SyntaxLike {
+	return c.MakeWithTemplates(Templates().Make())
+}
+
+func (c *syntaxClass_) MakeWithTemplates(templates TemplatesLike) SyntaxLike {
 	return &syntax_{
 		// Initialize the instance attributes.
-		class_: c,
+		class_:     c,
+		templates_: templates,
 	}
 }
 
@@ -61,7 +67,8 @@ func (c *syntaxClass_) Make() SyntaxLike {
 
 type syntax_ struct {
 	// Define the instance attributes.
-	class_ *syntaxClass_
+	class_     *syntaxClass_
+	templates_ TemplatesLike
 }
 
 // Public
@@ -86,7 +93,7 @@ func (v *syntax_) GenerateSyntaxNotation(
 // Private
 
 func (v *syntax_) getTemplate(name string) string {
-	var template = syntaxTemplates_.GetValue(name)
+	var template = v.templates_.GetTemplate("syntax/" + name)
 	return template
 }
 
@@ -124,6 +131,11 @@ func expandCopyright(copyright string) string {
 
 func generateVariableName(reference ast.ReferenceLike) string {
 	var mixedCase = reference.GetIdentifier().GetAny().(string)
+	var label = reference.GetOptionalLabel()
+	if col.IsDefined(label) {
+		// The label replaces the identifier as the name of the attribute.
+		mixedCase = label.GetLowercase()
+	}
 	var variableName = makeLowerCase(mixedCase)
 	var cardinality = reference.GetOptionalCardinality()
 	if col.IsDefined(cardinality) {
@@ -192,6 +204,19 @@ func generateVariableType(
 	return variableType
 }
 
+func isKeyword(text string) bool {
+	// A literal made up entirely of word characters is a reserved keyword.
+	if len(text) == 0 {
+		return false
+	}
+	for _, r := range text {
+		if !(uni.IsLetter(r) || uni.IsDigit(r) || r == '_') {
+			return false
+		}
+	}
+	return true
+}
+
 func isReserved(name string) bool {
 	return reserved_.ContainsValue(name)
 }
@@ -267,6 +292,32 @@ func makeUpperCase(mixedCase string) string {
 	return upperCase
 }
 
+func removeUnusedImports(implementation string) string {
+	// Some packages are only used by the code generated for certain syntaxes,
+	// so any aliased import that is never referenced, e.g. "abs." at the start
+	// of a word, is removed.
+	var start = sts.Index(implementation, "import (\n")
+	if start < 0 {
+		return implementation
+	}
+	var end = start + sts.Index(implementation[start:], "\n)\n")
+	var body = implementation[end:]
+	var lines = sts.Split(implementation[start:end], "\n")
+	var imports = lines[:1]
+	for _, line := range lines[1:] {
+		var fields = sts.Fields(line)
+		if len(fields) < 2 {
+			imports = append(imports, line)
+			continue
+		}
+		var reference = reg.MustCompile(`\b` + fields[0] + `\.`)
+		if reference.MatchString(body) {
+			imports = append(imports, line)
+		}
+	}
+	return implementation[:start] + sts.Join(imports, "\n") + body
+}
+
 func replaceAll(template string, name string, value string) string {
 	// <variableName> -> variableValue[_]
 	var variableName = makeLowerCase(name) + "_"
@@ -299,6 +350,18 @@ func replaceAll(template string, name string, value string) string {
 	return template
 }
 
+func unquoteLiteral(literal string) (text string, isCaseless bool) {
+	// A literal with a trailing "i" is matched without regard to case.
+	isCaseless = sts.HasSuffix(literal, "i")
+	literal = sts.TrimSuffix(literal, "i")
+	var err error
+	text, err = stc.Unquote(literal) // Remove the double quotes.
+	if err != nil {
+		panic(err)
+	}
+	return text, isCaseless
+}
+
 // Constants
 
 var reserved_ = col.Set[string](
@@ -368,9 +431,15 @@ pattern declarations:
   - ANY - Any language specific character.
   - LOWER - Any language specific lowercase character.
   - UPPER - Any language specific uppercase character.
+  - LETTER - Any language specific letter, with or without case.
   - DIGIT - Any language specific digit.
+  - SPACE - Any unicode whitespace character, including EOL characters.
+  - PUNCT - Any unicode punctuation character.
+  - SYMBOL - Any unicode symbol character.
   - CONTROL - Any environment specific (non-printable) control character.
   - EOL - The environment specific end-of-line character.
+  - UNICODE(Name) - Any character in the named unicode category or script,
+    e.g. UNICODE(Lu) or UNICODE(Greek).
 
 The excluded "~" prefix within a regular expression pattern may only be applied
 to a filtered set of possible characters.
@@ -0,0 +1,224 @@
+/*
+................................................................................
+.    Copyright (c) 2009-2024 Crater Dog Technologies.  All Rights Reserved.    .
+................................................................................
+.  DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               .
+.                                                                              .
+.  This code is free software; you can redistribute it and/or modify it under  .
+.  the terms of The MIT License (MIT), as published by the Open Source         .
+.  Initiative. (See https://opensource.org/license/MIT)                        .
+................................................................................
+*/
+
+package generator
+
+import (
+	fmt "fmt"
+	col "github.com/craterdog/go-collection-framework/v4"
+	abs "github.com/craterdog/go-collection-framework/v4/collection"
+	fsx "io/fs"
+	reg "regexp"
+	sts "strings"
+	uni "unicode"
+)
+
+// CLASS ACCESS
+
+// Reference
+
+var templatesClass = &templatesClass_{
+	// Initialize the class constants.
+}
+
+// Function
+
+func Templates() TemplatesClassLike {
+	return templatesClass
+}
+
+// CLASS METHODS
+
+// Target
+
+type templatesClass_ struct {
+	// Define the class constants.
+}
+
+// Constructors
+
+func (c *templatesClass_) Make() TemplatesLike {
+	return &templates_{
+		// Initialize the instance attributes.
+		class_:        c,
+		replacements_: map[string]string{},
+	}
+}
+
+// INSTANCE METHODS
+
+// Target
+
+type templates_ struct {
+	// Define the instance attributes.
+	class_        *templatesClass_
+	replacements_ map[string]string // An empty replacement is still a replacement.
+}
+
+// Public
+
+func (v *templates_) GetClass() TemplatesClassLike {
+	return v.class_
+}
+
+func (v *templates_) GetNames() abs.Sequential[string] {
+	var names = col.List[string]()
+	for generator, catalog := range templateCatalogs_ {
+		var iterator = catalog.GetKeys().GetIterator()
+		for iterator.HasNext() {
+			names.AppendValue(generator + "/" + iterator.GetNext())
+		}
+	}
+	names.SortValues()
+	return names
+}
+
+func (v *templates_) GetPlaceholders(name string) abs.Sequential[string] {
+	var placeholders = col.List[string]()
+	var required = v.getRequired(v.getDefault(name))
+	var iterator = required.GetIterator()
+	for iterator.HasNext() {
+		placeholders.AppendValue(iterator.GetNext().GetValue())
+	}
+	return placeholders
+}
+
+func (v *templates_) GetTemplate(name string) string {
+	var template, ok = v.replacements_[name]
+	if !ok {
+		template = v.getDefault(name)
+	}
+	return template
+}
+
+func (v *templates_) SetTemplate(
+	name string,
+	template string,
+) {
+	// The replacement must contain each required placeholder in some form.
+	var required = v.getRequired(v.getDefault(name))
+	var found = v.getRequired(template)
+	var missing []string
+	var iterator = required.GetIterator()
+	for iterator.HasNext() {
+		var association = iterator.GetNext()
+		if len(found.GetValue(association.GetKey())) == 0 {
+			missing = append(missing, association.GetValue())
+		}
+	}
+	if len(missing) > 0 {
+		var message = fmt.Sprintf(
+			"The template %q is missing the following required placeholders: %v",
+			name,
+			sts.Join(missing, ", "),
+		)
+		panic(message)
+	}
+	v.replacements_[name] = template
+}
+
+func (v *templates_) LoadTemplates(directory fsx.FS) {
+	// Each file is named for the template that it replaces, e.g.
+	// "scanner/classTemplate.go.tmpl" replaces "scanner/classTemplate".
+	var err = fsx.WalkDir(directory, ".", func(
+		path string,
+		entry fsx.DirEntry,
+		err error,
+	) error {
+		if err != nil || entry.IsDir() {
+			return err
+		}
+		var bytes []byte
+		bytes, err = fsx.ReadFile(directory, path)
+		if err != nil {
+			return err
+		}
+		var slash = sts.LastIndex(path, "/") + 1
+		var name, _, _ = sts.Cut(path[slash:], ".")
+		v.SetTemplate(path[:slash]+name, string(bytes))
+		return nil
+	})
+	if err != nil {
+		var message = fmt.Sprintf(
+			"The templates could not be loaded: %v",
+			err,
+		)
+		panic(message)
+	}
+}
+
+// Private
+
+func (v *templates_) getDefault(name string) string {
+	var generator, key, _ = sts.Cut(name, "/")
+	var catalog = templateCatalogs_[generator]
+	var template string
+	if catalog != nil {
+		template = catalog.GetValue(key)
+	}
+	if len(template) == 0 {
+		var message = fmt.Sprintf(
+			"The template %q is not defined.",
+			name,
+		)
+		panic(message)
+	}
+	return template
+}
+
+func (v *templates_) getRequired(template string) abs.CatalogLike[string, string] {
+	// Any case form of a placeholder will do, so each placeholder is keyed by
+	// its name without case or separators.  All caps placeholders are ignored
+	// since they may be literal text (e.g. "<EOF>"), as are the optional
+	// placeholders that only fill in the copyright notice and wiki link.
+	var required = col.Catalog[string, string]()
+	var matches = placeholderMatcher_.FindAllString(template, -1)
+	for _, placeholder := range matches {
+		var key = sts.ToLower(placeholder)
+		key = sts.NewReplacer("<", "", ">", "", "_", "", "-", "").Replace(key)
+		switch {
+		case sts.IndexFunc(placeholder, uni.IsLower) < 0:
+		case optionalPlaceholders_[key]:
+		case len(required.GetValue(key)) > 0:
+		default:
+			required.SetValue(key, placeholder)
+		}
+	}
+	return required
+}
+
+// PRIVATE GLOBALS
+
+// Constants
+
+var optionalPlaceholders_ = map[string]bool{
+	"copyright": true,
+	"notice":    true,
+	"wiki":      true,
+}
+
+var placeholderMatcher_ = reg.MustCompile(`<[A-Za-z][A-Za-z0-9_-]*>`)
+
+var templateCatalogs_ = map[string]abs.CatalogLike[string, string]{
+	"ast":        astTemplates_,
+	"formatter":  formatterTemplates_,
+	"grammar":    grammarTemplates_,
+	"node":       nodeTemplates_,
+	"parseerror": parseErrorTemplates_,
+	"parser":     parserTemplates_,
+	"processor":  processorTemplates_,
+	"scanner":    scannerTemplates_,
+	"syntax":     syntaxTemplates_,
+	"token":      tokenTemplates_,
+	"validator":  validatorTemplates_,
+	"visitor":    visitorTemplates_,
+}
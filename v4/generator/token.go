@@ -42,10 +42,15 @@ type tokenClass_ struct {
 // Constructors
 
 func (c *tokenClass_) Make() TokenLike {
+	return c.MakeWithTemplates(Templates().Make())
+}
+
+func (c *tokenClass_) MakeWithTemplates(templates TemplatesLike) TokenLike {
 	return &token_{
 		// Initialize the instance attributes.
-		class_:    c,
-		analyzer_: Analyzer().Make(),
+		class_:     c,
+		analyzer_:  Analyzer().Make(),
+		templates_: templates,
 	}
 }
 
@@ -55,8 +60,9 @@ func (c *tokenClass_) Make() TokenLike {
 
 type token_ struct {
 	// Define the instance attributes.
-	class_    *tokenClass_
-	analyzer_ AnalyzerLike
+	class_     *tokenClass_
+	analyzer_  AnalyzerLike
+	templates_ TemplatesLike
 }
 
 // Public
@@ -75,13 +81,14 @@ func (v *token_) GenerateTokenClass(
 	var notice = v.analyzer_.GetNotice()
 	var template = v.getTemplate(classTemplate)
 	implementation = replaceAll(template, "notice", notice)
+	implementation = removeUnusedImports(implementation)
 	return implementation
 }
 
 // Private
 
 func (v *token_) getTemplate(name string) string {
-	var template = tokenTemplates_.GetValue(name)
+	var template = v.templates_.GetTemplate("token/" + name)
 	return template
 }
 
@@ -128,12 +135,23 @@ func (c *tokenClass_) Make(
 	position uint,
 	type_ TokenType,
 	value string,
+) TokenLike {
+	return c.MakeWithOffset(line, position, 0, type_, value)
+}
+
+func (c *tokenClass_) MakeWithOffset(
+	line uint,
+	position uint,
+	offset uint,
+	type_ TokenType,
+	value string,
 ) TokenLike {
 	return &token_{
 		// Initialize the instance attributes.
 		class_:    c,
 		line_:     line,
 		position_: position,
+		offset_:   offset,
 		type_:     type_,
 		value_:    value,
 	}
@@ -148,6 +166,7 @@ type token_ struct {
 	class_    *tokenClass_
 	line_     uint
 	position_ uint
+	offset_   uint // A zero based index of the first byte of the token in the source.
 	type_     TokenType
 	value_    string
 }
@@ -168,6 +187,10 @@ func (v *token_) GetPosition() uint {
 	return v.position_
 }
 
+func (v *token_) GetOffset() uint {
+	return v.offset_
+}
+
 func (v *token_) GetType() TokenType {
 	return v.type_
 }
@@ -42,10 +42,15 @@ type validatorClass_ struct {
 // Constructors
 
 func (c *validatorClass_) Make() ValidatorLike {
+	return c.MakeWithTemplates(Templates().Make())
+}
+
+func (c *validatorClass_) MakeWithTemplates(templates TemplatesLike) ValidatorLike {
 	var validator = &validator_{
 		// Initialize the instance attributes.
-		class_:    c,
-		analyzer_: Analyzer().Make(),
+		class_:     c,
+		analyzer_:  Analyzer().Make(),
+		templates_: templates,
 	}
 	return validator
 }
@@ -56,8 +61,9 @@ func (c *validatorClass_) Make() ValidatorLike {
 
 type validator_ struct {
 	// Define the instance attributes.
-	class_    *validatorClass_
-	analyzer_ AnalyzerLike
+	class_     *validatorClass_
+	analyzer_  AnalyzerLike
+	templates_ TemplatesLike
 }
 
 // Public
@@ -81,6 +87,7 @@ func (v *validator_) GenerateValidatorClass(
 	implementation = replaceAll(implementation, "tokenValidators", tokenValidators)
 	var name = v.analyzer_.GetSyntaxName()
 	implementation = replaceAll(implementation, "name", name)
+	implementation = removeUnusedImports(implementation)
 	return implementation
 }
 
@@ -91,7 +98,7 @@ func (v *validator_) generateTokenValidators() string {
 	var iterator = v.analyzer_.GetTokenNames().GetIterator()
 	for iterator.HasNext() {
 		var tokenName = iterator.GetNext()
-		if tokenName == "delimiter" {
+		if tokenName == "delimiter" || tokenName == "keyword" {
 			continue
 		}
 		var isPlural = v.analyzer_.IsPlural(tokenName)
@@ -108,7 +115,7 @@ func (v *validator_) generateTokenValidators() string {
 }
 
 func (v *validator_) getTemplate(name string) string {
-	var template = validatorTemplates_.GetValue(name)
+	var template = v.templates_.GetTemplate("validator/" + name)
 	return template
 }
 
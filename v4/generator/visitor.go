@@ -44,10 +44,15 @@ type visitorClass_ struct {
 // Constructors
 
 func (c *visitorClass_) Make() VisitorLike {
+	return c.MakeWithTemplates(Templates().Make())
+}
+
+func (c *visitorClass_) MakeWithTemplates(templates TemplatesLike) VisitorLike {
 	var visitor = &visitor_{
 		// Initialize the instance attributes.
-		class_:    c,
-		analyzer_: Analyzer().Make(),
+		class_:     c,
+		analyzer_:  Analyzer().Make(),
+		templates_: templates,
 	}
 	return visitor
 }
@@ -58,8 +63,9 @@ func (c *visitorClass_) Make() VisitorLike {
 
 type visitor_ struct {
 	// Define the instance attributes.
-	class_    *visitorClass_
-	analyzer_ AnalyzerLike
+	class_     *visitorClass_
+	analyzer_  AnalyzerLike
+	templates_ TemplatesLike
 }
 
 // Public
@@ -83,6 +89,7 @@ func (v *visitor_) GenerateVisitorClass(
 	implementation = replaceAll(implementation, "syntaxName", syntaxName)
 	var methods = v.generateMethods()
 	implementation = replaceAll(implementation, "methods", methods)
+	implementation = removeUnusedImports(implementation)
 	return implementation
 }
 
@@ -195,6 +202,8 @@ func (v *visitor_) generateMethods() (
 			method = v.generateMultilineMethod(rule)
 		case col.IsDefined(v.analyzer_.GetReferences(rule)):
 			method = v.generateInlineMethod(rule)
+		case col.IsDefined(v.analyzer_.GetPrecedenceRule(rule)):
+			method = v.generateOperationMethod(rule)
 		}
 		method = replaceAll(method, "rule", rule)
 		methods += method
@@ -253,6 +262,23 @@ func (v *visitor_) generateMultilineToken(
 	return implementation
 }
 
+func (v *visitor_) generateOperationMethod(
+	className string,
+) (
+	method string,
+) {
+	// Both operands of a binary operation are instances of the precedence rule.
+	var precedenceRule = v.analyzer_.GetPrecedenceRule(className)
+	var identifier = ast.Identifier().Make(precedenceRule)
+	var reference = ast.Reference().Make(nil, identifier, nil, nil)
+	var implementation = v.generateInlineRule("left", reference)
+	implementation += v.generateInlineSlot(className, 1)
+	implementation += v.generateInlineRule("right", reference)
+	method = v.getTemplate(visitRuleMethod)
+	method = replaceAll(method, "implementation", implementation)
+	return method
+}
+
 func (v *visitor_) generatePlurality(
 	reference ast.ReferenceLike,
 ) (
@@ -287,7 +313,7 @@ func (v *visitor_) generatePlurality(
 // Private
 
 func (v *visitor_) getTemplate(name string) string {
-	var template = visitorTemplates_.GetValue(name)
+	var template = v.templates_.GetTemplate("visitor/" + name)
 	return template
 }
 
@@ -16,6 +16,7 @@ abstract syntax tree (AST) for this module:
   - Token captures the attributes associated with a parsed token.
   - Scanner is used to scan the source byte stream and recognize matching tokens.
   - Parser is used to process the token stream and generate the AST.
+  - ParseError captures the attributes associated with a syntax error.
   - Validator is used to validate the semantics associated with an AST.
   - Formatter is used to format an AST back into a canonical version of its source.
   - Visitor walks the AST and calls processor methods for each node in the tree.
@@ -64,6 +65,10 @@ const (
 	RepeatedToken
 	SpaceToken
 	UppercaseToken
+	PredicateToken
+	AssociativityToken
+	CaselessToken
+	KeywordToken
 )
 
 // Classes
@@ -88,6 +93,23 @@ type ParserClassLike interface {
 	Make() ParserLike
 }
 
+/*
+ParseErrorClassLike is a class interface that defines the complete set of
+class constants, constructors and functions that must be supported by each
+concrete parse-error-like class.
+*/
+type ParseErrorClassLike interface {
+	// Constructor
+	Make(
+		line uint,
+		position uint,
+		token TokenLike,
+		ruleName string,
+		expected string,
+		message string,
+	) ParseErrorLike
+}
+
 /*
 ProcessorClassLike is a class interface that defines the complete set of
 class constants, constructors and functions that must be supported by each
@@ -135,13 +157,20 @@ class constants, constructors and functions that must be supported by each
 concrete token-like class.
 */
 type TokenClassLike interface {
-	// Constructor
+	// Constructors
 	Make(
 		line uint,
 		position uint,
 		type_ TokenType,
 		value string,
 	) TokenLike
+	MakeWithOffset(
+		line uint,
+		position uint,
+		offset uint,
+		type_ TokenType,
+		value string,
+	) TokenLike
 }
 
 /*
@@ -195,6 +224,33 @@ type ParserLike interface {
 	ParseSource(
 		source string,
 	) ast.SyntaxLike
+	ParseSourceWithErrors(
+		source string,
+	) (
+		syntax ast.SyntaxLike,
+		errors abs.Sequential[ParseErrorLike],
+	)
+}
+
+/*
+ParseErrorLike is an instance interface that defines the complete set of
+instance attributes, abstractions and methods that must be supported by each
+instance of a concrete parse-error-like class.  The token attribute is nil if
+the error occurred at the end of the source.  The expected attribute contains
+the definition of the named rule that was being parsed.
+*/
+type ParseErrorLike interface {
+	// Public
+	GetClass() ParseErrorClassLike
+	Error() string
+
+	// Attribute
+	GetLine() uint
+	GetPosition() uint
+	GetToken() TokenLike
+	GetRuleName() string
+	GetExpected() string
+	GetMessage() string
 }
 
 /*
@@ -218,6 +274,7 @@ instance of a concrete scanner-like class.
 type ScannerLike interface {
 	// Public
 	GetClass() ScannerClassLike
+	Close()
 }
 
 /*
@@ -232,6 +289,7 @@ type TokenLike interface {
 	// Attribute
 	GetLine() uint
 	GetPosition() uint
+	GetOffset() uint
 	GetType() TokenType
 	GetValue() string
 }
@@ -272,6 +330,12 @@ Methodical defines the set of method signatures that must be supported
 by all methodical processors.
 */
 type Methodical interface {
+	ProcessAssociativity(
+		associativity string,
+	)
+	ProcessCaseless(
+		caseless string,
+	)
 	ProcessComment(
 		comment string,
 	)
@@ -304,6 +368,9 @@ type Methodical interface {
 	ProcessOptional(
 		optional string,
 	)
+	ProcessPredicate(
+		predicate string,
+	)
 	ProcessRepeated(
 		repeated string,
 	)
@@ -366,6 +433,19 @@ type Methodical interface {
 	PostprocessDefinition(
 		definition ast.DefinitionLike,
 	)
+	PreprocessDirective(
+		directive ast.DirectiveLike,
+		index uint,
+		size uint,
+	)
+	ProcessDirectiveSlot(
+		slot uint,
+	)
+	PostprocessDirective(
+		directive ast.DirectiveLike,
+		index uint,
+		size uint,
+	)
 	PreprocessElement(
 		element ast.ElementLike,
 	)
@@ -433,6 +513,19 @@ type Methodical interface {
 	PostprocessIdentifier(
 		identifier ast.IdentifierLike,
 	)
+	PreprocessImport(
+		import_ ast.ImportLike,
+		index uint,
+		size uint,
+	)
+	ProcessImportSlot(
+		slot uint,
+	)
+	PostprocessImport(
+		import_ ast.ImportLike,
+		index uint,
+		size uint,
+	)
 	PreprocessInline(
 		inline ast.InlineLike,
 	)
@@ -442,6 +535,28 @@ type Methodical interface {
 	PostprocessInline(
 		inline ast.InlineLike,
 	)
+	PreprocessLabel(
+		label ast.LabelLike,
+	)
+	ProcessLabelSlot(
+		slot uint,
+	)
+	PostprocessLabel(
+		label ast.LabelLike,
+	)
+	PreprocessLevel(
+		level ast.LevelLike,
+		index uint,
+		size uint,
+	)
+	ProcessLevelSlot(
+		slot uint,
+	)
+	PostprocessLevel(
+		level ast.LevelLike,
+		index uint,
+		size uint,
+	)
 	PreprocessLimit(
 		limit ast.LimitLike,
 	)
@@ -464,6 +579,28 @@ type Methodical interface {
 		index uint,
 		size uint,
 	)
+	PreprocessLookahead(
+		lookahead ast.LookaheadLike,
+	)
+	ProcessLookaheadSlot(
+		slot uint,
+	)
+	PostprocessLookahead(
+		lookahead ast.LookaheadLike,
+	)
+	PreprocessMode(
+		mode ast.ModeLike,
+		index uint,
+		size uint,
+	)
+	ProcessModeSlot(
+		slot uint,
+	)
+	PostprocessMode(
+		mode ast.ModeLike,
+		index uint,
+		size uint,
+	)
 	PreprocessMultiline(
 		multiline ast.MultilineLike,
 	)
@@ -482,6 +619,19 @@ type Methodical interface {
 	PostprocessNotice(
 		notice ast.NoticeLike,
 	)
+	PreprocessOperator(
+		operator ast.OperatorLike,
+		index uint,
+		size uint,
+	)
+	ProcessOperatorSlot(
+		slot uint,
+	)
+	PostprocessOperator(
+		operator ast.OperatorLike,
+		index uint,
+		size uint,
+	)
 	PreprocessOption(
 		option ast.OptionLike,
 	)
@@ -500,6 +650,15 @@ type Methodical interface {
 	PostprocessPattern(
 		pattern ast.PatternLike,
 	)
+	PreprocessPrecedence(
+		precedence ast.PrecedenceLike,
+	)
+	ProcessPrecedenceSlot(
+		slot uint,
+	)
+	PostprocessPrecedence(
+		precedence ast.PrecedenceLike,
+	)
 	PreprocessQuantified(
 		quantified ast.QuantifiedLike,
 	)
@@ -544,6 +703,15 @@ type Methodical interface {
 		index uint,
 		size uint,
 	)
+	PreprocessSeparator(
+		separator ast.SeparatorLike,
+	)
+	ProcessSeparatorSlot(
+		slot uint,
+	)
+	PostprocessSeparator(
+		separator ast.SeparatorLike,
+	)
 	PreprocessSyntax(
 		syntax ast.SyntaxLike,
 	)
@@ -575,4 +743,13 @@ type Methodical interface {
 	PostprocessText(
 		text ast.TextLike,
 	)
+	PreprocessTransition(
+		transition ast.TransitionLike,
+	)
+	ProcessTransitionSlot(
+		slot uint,
+	)
+	PostprocessTransition(
+		transition ast.TransitionLike,
+	)
 }
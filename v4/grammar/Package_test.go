@@ -14,10 +14,16 @@ package grammar_test
 
 import (
 	fmt "fmt"
+	col "github.com/craterdog/go-collection-framework/v4"
+	ast "github.com/craterdog/go-grammar-framework/v4/ast"
 	gra "github.com/craterdog/go-grammar-framework/v4/grammar"
 	ass "github.com/stretchr/testify/assert"
 	osx "os"
+	run "runtime"
+	sts "strings"
+	syn "sync"
 	tes "testing"
+	tim "time"
 )
 
 var filenames = []string{
@@ -50,3 +56,1042 @@ func TestRoundTrips(t *tes.T) {
 	}
 	fmt.Println("Done.")
 }
+
+const multilineSyntax = `!>
+NOTICE
+<!
+
+!>
+RULES
+<!
+Syntax: Statement+
+
+Statement:
+  - Call
+  - name
+
+Call: name "(" ")"
+
+!>
+EXPRESSIONS
+<!
+name: LOWER+
+
+`
+
+func TestNewlines(t *tes.T) {
+	// The newline tokens that are referenced by the rules must not be ignored.
+	var parser = gra.Parser().Make()
+	var syntax, errors = parser.ParseSourceWithErrors(multilineSyntax)
+	ass.True(t, errors.IsEmpty())
+	ass.Equal(t, 3, syntax.GetRules().GetSize())
+	ass.Equal(t, 1, syntax.GetExpressions().GetSize())
+}
+
+const sloppySyntax = `!>
+NOTICE
+<!
+
+!>
+RULES
+<!
+Syntax:   Call+   ! Each call is on its own line.
+
+Call:  name  "("  Argument{0..3}  ")"
+
+Argument:
+  -  name
+  -  number   ! A literal argument.
+
+!>
+EXPRESSIONS
+<!
+name:  (LOWER | '_')  ['a'..'z'  '0'..'9']*
+
+number: ~[CONTROL  ' ']+
+
+`
+
+const tidySyntax = `!>
+NOTICE
+<!
+
+!>
+RULES
+<!
+Syntax: Call+  ! Each call is on its own line.
+
+Call: name "(" Argument{0..3} ")"
+
+Argument:
+  - name
+  - number  ! A literal argument.
+
+!>
+EXPRESSIONS
+<!
+name: (LOWER | '_') ['a'..'z' '0'..'9']*
+
+number: ~[CONTROL ' ']+
+
+`
+
+func TestFormatter(t *tes.T) {
+	// The formatter puts back the canonical spacing between the tokens.
+	var parser = gra.Parser().Make()
+	var formatter = gra.Formatter().Make()
+	var syntax = parser.ParseSource(sloppySyntax)
+	ass.Equal(t, tidySyntax, formatter.FormatSyntax(syntax))
+	syntax = parser.ParseSource(tidySyntax)
+	ass.Equal(t, tidySyntax, formatter.FormatSyntax(syntax))
+}
+
+const badSyntax = `!>
+NOTICE
+<!
+
+!>
+RULES
+<!
+Syntax: Rule+
+
+Rule "x"
+
+Other: Rule Syntax
+
+Bad:: name
+
+!>
+EXPRESSIONS
+<!
+name: LOWER+
+
+oops # "x"
+
+value: DIGIT+
+
+`
+
+func TestParseErrors(t *tes.T) {
+	var parser = gra.Parser().Make()
+	var syntax, errors = parser.ParseSourceWithErrors(badSyntax)
+	ass.Equal(t, 3, errors.GetSize())
+	var iterator = errors.GetIterator()
+
+	// An unexpected token.
+	var error_ = iterator.GetNext()
+	ass.Equal(t, uint(10), error_.GetLine())
+	ass.Equal(t, uint(6), error_.GetPosition())
+	ass.Equal(t, gra.LiteralToken, error_.GetToken().GetType())
+	ass.Equal(t, `"x"`, error_.GetToken().GetValue())
+	ass.Equal(t, "Rule", error_.GetRuleName())
+	ass.Equal(t, `uppercase ":" Definition newline+`, error_.GetExpected())
+	ass.Equal(
+		t,
+		`10:6: unexpected literal "x" while parsing Rule: uppercase ":" Definition newline+`,
+		error_.Error(),
+	)
+
+	// A missing definition.
+	error_ = iterator.GetNext()
+	ass.Equal(t, uint(14), error_.GetLine())
+	ass.Equal(t, uint(5), error_.GetPosition())
+	ass.Equal(t, "Rule", error_.GetRuleName())
+
+	// An unrecognized character.
+	error_ = iterator.GetNext()
+	ass.Equal(t, uint(21), error_.GetLine())
+	ass.Equal(t, uint(6), error_.GetPosition())
+	ass.Equal(t, gra.ErrorToken, error_.GetToken().GetType())
+
+	// The valid rules and expressions are still parsed.
+	ass.Equal(t, 2, syntax.GetRules().GetSize())
+	ass.Equal(t, 2, syntax.GetExpressions().GetSize())
+	ass.Panics(t, func() { parser.ParseSource(badSyntax) })
+
+	// The end of the source is reported without a token.
+	error_ = gra.ParseError().Make(22, 1, nil, "", "", "")
+	ass.Equal(t, "22:1: unexpected end of source", error_.Error())
+}
+
+const sameLineSyntax = `!>
+NOTICE
+<!
+
+!>
+RULES
+<!
+Syntax: Rule+
+
+Bad: "a" ) Good: "b"
+
+Worse "c" Fine: name
+
+!>
+EXPRESSIONS
+<!
+name: LOWER+
+
+`
+
+func TestSynchronization(t *tes.T) {
+	var parser = gra.Parser().Make()
+	var syntax, errors = parser.ParseSourceWithErrors(sameLineSyntax)
+	ass.Equal(t, 2, errors.GetSize())
+	var iterator = errors.GetIterator()
+
+	// A rule missing its newline.
+	var error_ = iterator.GetNext()
+	ass.Equal(t, uint(10), error_.GetLine())
+	ass.Equal(t, uint(10), error_.GetPosition())
+	ass.Equal(t, `)`, error_.GetToken().GetValue())
+	ass.Equal(t, "Rule", error_.GetRuleName())
+
+	// A rule missing its colon.
+	error_ = iterator.GetNext()
+	ass.Equal(t, uint(12), error_.GetLine())
+	ass.Equal(t, uint(7), error_.GetPosition())
+	ass.Equal(t, `"c"`, error_.GetToken().GetValue())
+	ass.Equal(t, "Rule", error_.GetRuleName())
+
+	// The rules that begin on the error lines are still parsed.
+	var rules = syntax.GetRules().GetIterator()
+	ass.Equal(t, "Syntax", rules.GetNext().GetUppercase())
+	ass.Equal(t, "Good", rules.GetNext().GetUppercase())
+	ass.Equal(t, "Fine", rules.GetNext().GetUppercase())
+	ass.False(t, rules.HasNext())
+	ass.Equal(t, 1, syntax.GetExpressions().GetSize())
+}
+
+const brokenSyntax = `!>
+NOTICE
+<!
+
+!>
+RULES
+<!
+Syntax: Rule+
+
+One "a"
+
+Two "b"
+
+Three "c"
+
+Good: name
+
+!>
+EXPRESSIONS
+<!
+name: LOWER+
+
+`
+
+func TestConsecutiveErrors(t *tes.T) {
+	var parser = gra.Parser().Make()
+	var syntax, errors = parser.ParseSourceWithErrors(brokenSyntax)
+
+	// Each broken rule is reported.
+	ass.Equal(t, 3, errors.GetSize())
+	var iterator = errors.GetIterator()
+	for _, line := range []uint{10, 12, 14} {
+		var error_ = iterator.GetNext()
+		ass.Equal(t, line, error_.GetLine())
+		ass.Equal(t, "Rule", error_.GetRuleName())
+	}
+
+	// The rules that follow them are still parsed.
+	ass.Equal(t, 2, syntax.GetRules().GetSize())
+}
+
+func TestScannerCleanup(t *tes.T) {
+	// The missing notice aborts the parse long before the source is scanned.
+	var source = sts.Repeat("Syntax: Rule+\n", 100)
+	var before = run.NumGoroutine()
+	var parser = gra.Parser().Make()
+	for i := 0; i < 100; i++ {
+		var _, errors = parser.ParseSourceWithErrors(source)
+		ass.Equal(t, 1, errors.GetSize())
+	}
+
+	// Give the background scanners a moment to exit.
+	var deadline = tim.Now().Add(tim.Second)
+	for run.NumGoroutine() > before && tim.Now().Before(deadline) {
+		tim.Sleep(10 * tim.Millisecond)
+	}
+	ass.Equal(t, before, run.NumGoroutine())
+}
+
+func TestConcurrentClose(t *tes.T) {
+	// Closing a scanner more than once, even concurrently, is harmless.  The
+	// closers must run in parallel to expose any race between them.
+	defer run.GOMAXPROCS(run.GOMAXPROCS(8))
+	var source = sts.Repeat("Syntax: Rule+\n", 100)
+	var before = run.NumGoroutine()
+	for i := 0; i < 500; i++ {
+		var tokens = col.Queue[gra.TokenLike](4)
+		var scanner = gra.Scanner().Make(source, tokens)
+		var start = make(chan bool)
+		var group syn.WaitGroup
+		for j := 0; j < 8; j++ {
+			group.Add(1)
+			go func() {
+				defer group.Done()
+				<-start // Release all of the closers at once.
+				scanner.Close()
+			}()
+		}
+		close(start)
+		group.Wait()
+		scanner.Close()
+	}
+
+	// Give the background scanners a moment to exit.
+	var deadline = tim.Now().Add(tim.Second)
+	for run.NumGoroutine() > before && tim.Now().Before(deadline) {
+		tim.Sleep(10 * tim.Millisecond)
+	}
+	ass.Equal(t, before, run.NumGoroutine())
+}
+
+const spanSyntax = `!>
+NOTICE
+<!
+
+!>
+RULES
+<!
+Syntax: Rule+
+
+!>
+EXPRESSIONS
+<!
+name: LOWER+
+
+`
+
+func TestSpans(t *tes.T) {
+	var parser = gra.Parser().Make()
+	var syntax = parser.ParseSource(spanSyntax)
+	var span = syntax.GetSpan()
+	ass.Equal(t, uint(1), span.GetStart().GetLine())
+	ass.Equal(t, uint(1), span.GetStart().GetColumn())
+	ass.Equal(t, uint(0), span.GetStart().GetOffset())
+	ass.Equal(t, uint(len(spanSyntax)), span.GetEnd().GetOffset())
+
+	// The rule includes its trailing newlines.
+	var rule = syntax.GetRules().GetIterator().GetNext()
+	span = rule.GetSpan()
+	var offset = uint(sts.Index(spanSyntax, "Syntax:"))
+	ass.Equal(t, uint(8), span.GetStart().GetLine())
+	ass.Equal(t, uint(1), span.GetStart().GetColumn())
+	ass.Equal(t, offset, span.GetStart().GetOffset())
+	ass.Equal(t, uint(10), span.GetEnd().GetLine())
+	ass.Equal(t, uint(1), span.GetEnd().GetColumn())
+	ass.Equal(t, offset+uint(len("Syntax: Rule+\n\n")), span.GetEnd().GetOffset())
+
+	// The definition follows the colon on the same line.
+	span = rule.GetDefinition().GetSpan()
+	ass.Equal(t, uint(8), span.GetStart().GetLine())
+	ass.Equal(t, uint(9), span.GetStart().GetColumn())
+	ass.Equal(t, offset+8, span.GetStart().GetOffset())
+	ass.Equal(t, uint(8), span.GetEnd().GetLine())
+	ass.Equal(t, uint(14), span.GetEnd().GetColumn())
+	ass.Equal(t, offset+13, span.GetEnd().GetOffset())
+
+	// Expressions have spans too.
+	var expression = syntax.GetExpressions().GetIterator().GetNext()
+	span = expression.GetSpan()
+	ass.Equal(t, uint(13), span.GetStart().GetLine())
+	ass.Equal(t, uint(15), span.GetEnd().GetLine())
+
+	// Nodes made outside of the parser have no span unless one is given.
+	var copied = ast.Rule().Make(
+		rule.GetUppercase(),
+		rule.GetDefinition(),
+		rule.GetNewlines(),
+	)
+	ass.Nil(t, copied.GetSpan())
+	copied = ast.Rule().MakeWithSpan(
+		rule.GetSpan(),
+		rule.GetUppercase(),
+		rule.GetDefinition(),
+		rule.GetNewlines(),
+	)
+	ass.Equal(t, rule.GetSpan(), copied.GetSpan())
+
+	// Tokens made without an offset start at the beginning of the source.
+	var token = gra.Token().Make(2, 3, gra.UppercaseToken, "Rule")
+	ass.Equal(t, uint(0), token.GetOffset())
+	token = gra.Token().MakeWithOffset(2, 3, 12, gra.UppercaseToken, "Rule")
+	ass.Equal(t, uint(12), token.GetOffset())
+}
+
+const invalidSyntax = `!>
+NOTICE
+<!
+
+!>
+RULES
+<!
+Syntax: Rule+
+
+Rule: name Foo
+
+Rule: name
+
+Orphan: name
+
+!>
+EXPRESSIONS
+<!
+name: LOWER+ bar
+
+unused: DIGIT
+
+`
+
+func TestValidation(t *tes.T) {
+	var parser = gra.Parser().Make()
+	var syntax = parser.ParseSource(invalidSyntax)
+	var validator = gra.Validator().Make()
+	defer func() {
+		var message = recover().(string)
+		ass.Equal(t, `The syntax contains the following problems:
+  12:1: The rule "Rule" is defined more than once.
+  10:12: The rule "Foo" is referenced but never defined.
+  19:14: The expression "bar" is referenced but never defined.
+  14:1: The rule "Orphan" cannot be reached from the "Syntax" rule.
+  21:1: The expression "unused" cannot be reached from the "Syntax" rule.`, message)
+	}()
+	validator.ValidateSyntax(syntax)
+}
+
+const importingSyntax = `!>
+NOTICE
+<!
+
+@"Common.cdsn"
+@"Numbers.cdsn"  ! Defines the number expression.
+
+!>
+RULES
+<!
+Syntax: name number
+
+!>
+EXPRESSIONS
+<!
+`
+
+func TestImports(t *tes.T) {
+	var parser = gra.Parser().Make()
+	var syntax = parser.ParseSource(importingSyntax)
+	var imports = syntax.GetImports()
+	ass.Equal(t, 2, imports.GetSize())
+	var import_ = imports.GetIterator().GetNext()
+	ass.Equal(t, `"Common.cdsn"`, import_.GetLiteral())
+	ass.Equal(t, uint(5), import_.GetSpan().GetStart().GetLine())
+	ass.Equal(t, 0, syntax.GetExpressions().GetSize())
+
+	// The imports survive a round trip through the formatter.
+	var formatter = gra.Formatter().Make()
+	ass.Equal(t, importingSyntax, formatter.FormatSyntax(syntax))
+
+	// The imports must be resolved before the syntax can be validated.
+	var validator = gra.Validator().Make()
+	defer func() {
+		var message = recover().(string)
+		ass.Equal(t, `The syntax contains the following problems:
+  5:1: The import of "Common.cdsn" must be resolved before the syntax is validated.
+  6:1: The import of "Numbers.cdsn" must be resolved before the syntax is validated.
+  11:9: The expression "name" is referenced but never defined.
+  11:14: The expression "number" is referenced but never defined.`, message)
+	}()
+	validator.ValidateSyntax(syntax)
+}
+
+const rulelessSyntax = `!>
+NOTICE
+<!
+
+@"Rules.cdsn"
+
+!>
+RULES
+<!
+
+!>
+EXPRESSIONS
+<!
+name: LOWER+
+
+`
+
+func TestRulelessSyntax(t *tes.T) {
+	// A syntax whose rules are all imported may separate its headers by a
+	// blank line.
+	var parser = gra.Parser().Make()
+	var syntax, errors = parser.ParseSourceWithErrors(rulelessSyntax)
+	ass.True(t, errors.IsEmpty())
+	ass.Equal(t, 0, syntax.GetRules().GetSize())
+	ass.Equal(t, 1, syntax.GetExpressions().GetSize())
+	ass.Equal(t, "\n", syntax.GetOptionalNewline())
+
+	// The blank line survives a round trip through the formatter.
+	var formatter = gra.Formatter().Make()
+	ass.Equal(t, rulelessSyntax, formatter.FormatSyntax(syntax))
+}
+
+const lookaheadSyntax = `!>
+NOTICE
+<!
+
+!>
+RULES
+<!
+Syntax: Block
+
+Block: "{" Statement* "}"
+
+Statement: !"}" &name Call  ! Only calls are statements.
+
+Call: name "(" ")"
+
+Bad: &&name !name+
+
+!>
+EXPRESSIONS
+<!
+name: LOWER+
+
+`
+
+func TestLookahead(t *tes.T) {
+	var parser = gra.Parser().Make()
+	var syntax = parser.ParseSource(lookaheadSyntax)
+	var rules = syntax.GetRules().GetIterator()
+	rules.ToSlot(2)
+	var statement = rules.GetNext()
+	var terms = statement.GetDefinition().GetAny().(ast.InlineLike).GetTerms()
+	ass.Equal(t, 3, terms.GetSize())
+	var lookahead = terms.GetIterator().GetNext().GetAny().(ast.LookaheadLike)
+	ass.Equal(t, "!", lookahead.GetPredicate())
+	ass.Equal(t, `"}"`, lookahead.GetTerm().GetAny())
+
+	// The lookaheads survive a round trip through the formatter.
+	var formatter = gra.Formatter().Make()
+	ass.Equal(t, lookaheadSyntax, formatter.FormatSyntax(syntax))
+
+	// A lookahead may only be applied to a single reference or literal.
+	var validator = gra.Validator().Make()
+	defer func() {
+		var message = recover().(string)
+		ass.Equal(t, `The syntax contains the following problems:
+  16:6: A lookahead predicate cannot be applied to another lookahead predicate.
+  16:13: The lookahead predicate for "name" cannot specify a cardinality.
+  16:1: The rule "Bad" cannot be reached from the "Syntax" rule.`, message)
+	}()
+	validator.ValidateSyntax(syntax)
+}
+
+const precedenceSyntax = `!>
+NOTICE
+<!
+
+!>
+RULES
+<!
+Syntax: Sum
+
+Sum: % Factor  ! The arithmetic operations.
+  = "==" "<"
+  < "+" "-"
+  < "*" "/" "+"
+  > "^"  ! Exponentiation groups from the right.
+
+Factor:
+  - Group
+  - number
+
+Group: "(" Sum ")"
+
+SumOperation: number
+
+!>
+EXPRESSIONS
+<!
+number: DIGIT+
+
+`
+
+func TestPrecedence(t *tes.T) {
+	var parser = gra.Parser().Make()
+	var syntax = parser.ParseSource(precedenceSyntax)
+	var rules = syntax.GetRules().GetIterator()
+	rules.ToSlot(1)
+	var sum = rules.GetNext()
+	var precedence = sum.GetDefinition().GetAny().(ast.PrecedenceLike)
+	ass.Equal(t, "Factor", precedence.GetIdentifier().GetAny())
+	ass.Equal(t, 4, precedence.GetLevels().GetSize())
+	var level = precedence.GetLevels().GetIterator().GetNext()
+	ass.Equal(t, "=", level.GetAssociativity())
+	ass.Equal(t, 2, level.GetOperators().GetSize())
+
+	// The precedence table survives a round trip through the formatter.
+	var formatter = gra.Formatter().Make()
+	ass.Equal(t, precedenceSyntax, formatter.FormatSyntax(syntax))
+
+	// Each operator belongs to a single level and the operations need a class.
+	var validator = gra.Validator().Make()
+	defer func() {
+		var message = recover().(string)
+		ass.Equal(t, `The syntax contains the following problems:
+  10:6: The rule "SumOperation" clashes with the operations of the "Sum" precedence table.
+  13:13: The operator "+" appears more than once in the "Sum" precedence table.
+  22:1: The rule "SumOperation" cannot be reached from the "Syntax" rule.`, message)
+	}()
+	validator.ValidateSyntax(syntax)
+}
+
+const separatorSyntax = `!>
+NOTICE
+<!
+
+!>
+RULES
+<!
+Syntax: Array Tuple
+
+Array: "[" Value* / ","? "]"
+
+Tuple: "(" number{2..} / ";" ")"
+
+Value: number / ","
+
+!>
+EXPRESSIONS
+<!
+number: DIGIT+
+
+`
+
+func TestSeparators(t *tes.T) {
+	var parser = gra.Parser().Make()
+	var syntax = parser.ParseSource(separatorSyntax)
+	var rules = syntax.GetRules().GetIterator()
+	rules.ToSlot(1)
+	var array = rules.GetNext()
+	var terms = array.GetDefinition().GetAny().(ast.InlineLike).GetTerms().GetIterator()
+	terms.ToSlot(1)
+	var reference = terms.GetNext().GetAny().(ast.ReferenceLike)
+	var separator = reference.GetOptionalSeparator()
+	ass.Equal(t, `","`, separator.GetLiteral())
+	ass.Equal(t, "?", separator.GetOptionalTrailing())
+
+	// The separators survive a round trip through the formatter.
+	var formatter = gra.Formatter().Make()
+	ass.Equal(t, separatorSyntax, formatter.FormatSyntax(syntax))
+
+	// Only a reference that may repeat can have a separator.
+	var validator = gra.Validator().Make()
+	defer func() {
+		var message = recover().(string)
+		ass.Equal(t, `The syntax contains the following problems:
+  14:15: The separator for "number" requires a cardinality that allows more than one instance.`, message)
+	}()
+	validator.ValidateSyntax(syntax)
+}
+
+const labelSyntax = `!>
+NOTICE
+<!
+
+!>
+RULES
+<!
+Syntax: Pair !key:name
+
+Pair: key:name value:name? key:number
+
+!>
+EXPRESSIONS
+<!
+name: LOWER+
+
+number: DIGIT+
+
+`
+
+func TestLabels(t *tes.T) {
+	var parser = gra.Parser().Make()
+	var syntax = parser.ParseSource(labelSyntax)
+	var rules = syntax.GetRules().GetIterator()
+	rules.ToSlot(1)
+	var pair = rules.GetNext()
+	var terms = pair.GetDefinition().GetAny().(ast.InlineLike).GetTerms().GetIterator()
+	var reference = terms.GetNext().GetAny().(ast.ReferenceLike)
+	ass.Equal(t, "key", reference.GetOptionalLabel().GetLowercase())
+	ass.Equal(t, "name", reference.GetIdentifier().GetAny())
+
+	// The labels survive a round trip through the formatter.
+	var formatter = gra.Formatter().Make()
+	ass.Equal(t, labelSyntax, formatter.FormatSyntax(syntax))
+
+	// Each label must name a distinct attribute.
+	var validator = gra.Validator().Make()
+	defer func() {
+		var message = recover().(string)
+		ass.Equal(t, `The syntax contains the following problems:
+  8:14: The lookahead predicate for "name" cannot specify a label.
+  10:28: The label "key" is used more than once in the "Pair" rule.`, message)
+	}()
+	validator.ValidateSyntax(syntax)
+}
+
+const reservedSyntax = `!>
+NOTICE
+<!
+
+!>
+RULES
+<!
+Syntax: Span+
+
+Span: Position extent:number
+
+Position: line:number span:span
+
+!>
+EXPRESSIONS
+<!
+number: DIGIT+
+
+span: DIGIT+
+
+`
+
+func TestReservedNames(t *tes.T) {
+	// The names used by the generated AST classes cannot be redefined.
+	var parser = gra.Parser().Make()
+	var syntax = parser.ParseSource(reservedSyntax)
+	var validator = gra.Validator().Make()
+	defer func() {
+		var message = recover().(string)
+		ass.Equal(t, `The syntax contains the following problems:
+  10:1: The rule name "Span" is reserved for the generated AST classes.
+  12:1: The rule name "Position" is reserved for the generated AST classes.
+  19:1: The expression name "span" is reserved for the generated AST classes.
+  12:23: The label name "span" is reserved for the generated AST classes.`, message)
+	}()
+	validator.ValidateSyntax(syntax)
+}
+
+const caselessSyntax = `!>
+NOTICE
+<!
+
+!>
+RULES
+<!
+Syntax: "select"i name "Limit" hex
+
+!>
+EXPRESSIONS
+<!
+hex: (?i) "0x" ['0'..'9' 'a'..'f']+
+
+name: LOWER+
+
+`
+
+func TestCaseless(t *tes.T) {
+	var parser = gra.Parser().Make()
+	var syntax = parser.ParseSource(caselessSyntax)
+	var rule = syntax.GetRules().GetIterator().GetNext()
+	var terms = rule.GetDefinition().GetAny().(ast.InlineLike).GetTerms().GetIterator()
+	ass.Equal(t, `"select"i`, terms.GetNext().GetAny())
+	var expression = syntax.GetExpressions().GetIterator().GetNext()
+	ass.Equal(t, "(?i)", expression.GetOptionalCaseless())
+
+	// The case markers survive a round trip through the formatter.
+	var formatter = gra.Formatter().Make()
+	ass.Equal(t, caselessSyntax, formatter.FormatSyntax(syntax))
+	var validator = gra.Validator().Make()
+	validator.ValidateSyntax(syntax)
+}
+
+const recursiveSyntax = `!>
+NOTICE
+<!
+
+!>
+RULES
+<!
+Syntax: Sum Statement Padding
+
+Sum: Sum "+" name
+
+Statement: Modifier* Block
+
+Modifier: name
+
+Block:
+  - Statement
+  - name
+
+Padding: Empty Padded
+
+Empty: name?
+
+Padded:
+  - Padding
+  - name
+
+!>
+EXPRESSIONS
+<!
+name: LOWER+
+
+`
+
+func TestLeftRecursion(t *tes.T) {
+	var parser = gra.Parser().Make()
+	var syntax = parser.ParseSource(recursiveSyntax)
+	var validator = gra.Validator().Make()
+	defer func() {
+		var message = recover().(string)
+		ass.Equal(t, `The syntax contains the following problems:
+  10:1: The rule "Sum" is left recursive: Sum -> Sum
+  12:1: The rule "Statement" is left recursive: Statement -> Block -> Statement
+  20:1: The rule "Padding" is left recursive: Padding -> Padded -> Padding`, message)
+	}()
+	validator.ValidateSyntax(syntax)
+}
+
+const nullableSyntax = `!>
+NOTICE
+<!
+
+!>
+RULES
+<!
+Syntax: List Tokens
+
+List: Item+
+
+Item: Empty Empty
+
+Empty: name?
+
+Tokens: blank* name word
+
+!>
+EXPRESSIONS
+<!
+blank: " "?
+
+name: LOWER+ ("-"?)*
+
+word: LOWER blank+
+
+`
+
+func TestNullableRepetitions(t *tes.T) {
+	var parser = gra.Parser().Make()
+	var syntax = parser.ParseSource(nullableSyntax)
+	var validator = gra.Validator().Make()
+	defer func() {
+		var message = recover().(string)
+		ass.Equal(t, `The syntax contains the following problems:
+  10:7: The rule "Item" may match nothing so it cannot be repeated without a limit.
+  23:14: The group may match nothing so it cannot be repeated without a limit.
+  25:13: The expression "blank" may match nothing so it cannot be repeated without a limit.`, message)
+	}()
+	validator.ValidateSyntax(syntax)
+}
+
+const separatedSyntax = `!>
+NOTICE
+<!
+
+!>
+RULES
+<!
+Syntax: List Chain
+
+List: Item* / ","
+
+Chain: Item{2} / "," Chain?
+
+Item: name?
+
+!>
+EXPRESSIONS
+<!
+name: LOWER+
+
+`
+
+func TestSeparatedRepetitions(t *tes.T) {
+	// A separator consumes a token between each pair of instances, so the
+	// instances may match nothing and the rule is not left recursive.
+	var parser = gra.Parser().Make()
+	var syntax = parser.ParseSource(separatedSyntax)
+	var validator = gra.Validator().Make()
+	validator.ValidateSyntax(syntax)
+}
+
+const intrinsicSyntax = `!>
+NOTICE
+<!
+
+!>
+RULES
+<!
+Syntax: word symbol
+
+!>
+EXPRESSIONS
+<!
+symbol: [PUNCT SYMBOL]+ ~[SPACE]?
+
+word: (LETTER | UNICODE(Greek) | UNICODE(Klingon)) SPACE*
+
+`
+
+func TestIntrinsics(t *tes.T) {
+	var parser = gra.Parser().Make()
+	var syntax = parser.ParseSource(intrinsicSyntax)
+
+	// The parameterised intrinsic is scanned as a single token.
+	var formatter = gra.Formatter().Make()
+	ass.Equal(t, intrinsicSyntax, formatter.FormatSyntax(syntax))
+	var validator = gra.Validator().Make()
+	defer func() {
+		var message = recover().(string)
+		ass.Equal(t, `The syntax contains the following problems:
+  15:34: The unicode category or script "Klingon" is not defined.`, message)
+	}()
+	validator.ValidateSyntax(syntax)
+}
+
+const directiveSyntax = `!>
+NOTICE
+<!
+
+$indentation  ! Blocks are delimited by indentation.
+$folding
+
+!>
+RULES
+<!
+Syntax: name indent name dedent
+
+!>
+EXPRESSIONS
+<!
+name: LOWER+
+
+`
+
+func TestDirectives(t *tes.T) {
+	var parser = gra.Parser().Make()
+	var syntax = parser.ParseSource(directiveSyntax)
+	var directives = syntax.GetDirectives().GetIterator()
+	ass.Equal(t, "indentation", directives.GetNext().GetLowercase())
+	ass.Equal(t, "folding", directives.GetNext().GetLowercase())
+
+	// The directives survive a round trip through the formatter.
+	var formatter = gra.Formatter().Make()
+	ass.Equal(t, directiveSyntax, formatter.FormatSyntax(syntax))
+
+	// The indentation tokens need not be defined but other options are unknown.
+	var validator = gra.Validator().Make()
+	defer func() {
+		var message = recover().(string)
+		ass.Equal(t, `The syntax contains the following problems:
+  6:1: The scanner option "folding" is not supported.`, message)
+	}()
+	validator.ValidateSyntax(syntax)
+}
+
+const modeSyntax = `!>
+NOTICE
+<!
+
+!>
+RULES
+<!
+Document: Chunk+
+
+Chunk:
+  - Block
+  - text
+
+Block: open name* close
+
+!>
+EXPRESSIONS
+<!
+open: '{' -> code
+
+text: ~['{']+
+
+[code]  ! The tokens within a block.
+close: '}' ->
+
+name: LOWER+
+
+[block]
+other: '{' -> missing
+
+`
+
+func TestModes(t *tes.T) {
+	var parser = gra.Parser().Make()
+	var syntax = parser.ParseSource(modeSyntax)
+	var modes = syntax.GetModes().GetIterator()
+	var mode = modes.GetNext()
+	ass.Equal(t, "code", mode.GetLowercase())
+	ass.Equal(t, 2, mode.GetExpressions().GetSize())
+	ass.Equal(t, "block", modes.GetNext().GetLowercase())
+
+	// The modes and transitions survive a round trip through the formatter.
+	var formatter = gra.Formatter().Make()
+	ass.Equal(t, modeSyntax, formatter.FormatSyntax(syntax))
+
+	// A transition may only switch to a defined mode.
+	var validator = gra.Validator().Make()
+	defer func() {
+		var message = recover().(string)
+		ass.Equal(t, `The syntax contains the following problems:
+  29:12: The mode "missing" is not defined.
+  29:1: The expression "other" cannot be reached from the "Document" rule.`, message)
+	}()
+	validator.ValidateSyntax(syntax)
+}
+
+func BenchmarkScanner(b *tes.B) {
+	var bytes, err = osx.ReadFile("../Syntax.cdsn")
+	if err != nil {
+		panic(err)
+	}
+	var source = string(bytes)
+
+	// The scanning time per byte should not grow with the size of the source.
+	for _, size := range []int{1, 10, 100, 1000} {
+		var large = sts.Repeat(source, size)
+		b.Run(fmt.Sprintf("%dKB", len(large)/1024), func(b *tes.B) {
+			b.SetBytes(int64(len(large)))
+			for i := 0; i < b.N; i++ {
+				var tokens = col.Queue[gra.TokenLike](16)
+				gra.Scanner().Make(large, tokens)
+				var ok = true
+				for ok {
+					_, ok = tokens.RemoveHead()
+				}
+			}
+		})
+	}
+}
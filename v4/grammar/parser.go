@@ -18,6 +18,7 @@ import (
 	abs "github.com/craterdog/go-collection-framework/v4/collection"
 	ast "github.com/craterdog/go-grammar-framework/v4/ast"
 	sts "strings"
+	utf "unicode/utf8"
 )
 
 // CLASS ACCESS
@@ -27,7 +28,7 @@ import (
 var parserClass = &parserClass_{
 	// Initialize the class constants.
 	queueSize_: 16,
-	stackSize_: 4,
+	stackSize_: unlimited, // Backtracking may put back every token in a rule.
 }
 
 // Function
@@ -62,9 +63,11 @@ func (c *parserClass_) Make() ParserLike {
 type parser_ struct {
 	// Define the instance attributes.
 	class_  *parserClass_
-	source_ string                   // The original source code.
-	tokens_ abs.QueueLike[TokenLike] // A queue of unread tokens from the scanner.
-	next_   abs.StackLike[TokenLike] // A stack of read, but unprocessed tokens.
+	source_ string                       // The original source code.
+	tokens_ abs.QueueLike[TokenLike]     // A queue of unread tokens from the scanner.
+	next_   abs.StackLike[TokenLike]     // A stack of read, but unprocessed tokens.
+	errors_ abs.ListLike[ParseErrorLike] // A list of the syntax errors found.
+	parsed_ []TokenLike                  // The tokens that have been parsed so far.
 }
 
 // Public
@@ -74,22 +77,42 @@ func (v *parser_) GetClass() ParserClassLike {
 }
 
 func (v *parser_) ParseSource(source string) ast.SyntaxLike {
+	var syntax, errors = v.ParseSourceWithErrors(source)
+	if !errors.IsEmpty() {
+		// Report the first syntax error.
+		var error_ = errors.GetIterator().GetNext()
+		var message = v.formatError(error_)
+		panic(message)
+	}
+	return syntax
+}
+
+func (v *parser_) ParseSourceWithErrors(source string) (
+	syntax ast.SyntaxLike,
+	errors abs.Sequential[ParseErrorLike],
+) {
 	v.source_ = source
 	v.tokens_ = col.Queue[TokenLike](parserClass.queueSize_)
 	v.next_ = col.Stack[TokenLike](parserClass.stackSize_)
+	v.errors_ = col.List[ParseErrorLike]()
+	v.parsed_ = nil
+	errors = v.errors_
+	defer v.recoverError() // Any syntax errors are added to the errors list.
 
 	// The scanner runs in a separate Go routine.
-	Scanner().Make(v.source_, v.tokens_)
+	var scanner = Scanner().Make(v.source_, v.tokens_)
+	defer scanner.Close() // The scanner must not outlive the parse.
 
 	// Attempt to parse the syntax.
-	var syntax, token, ok = v.parseSyntax()
+	var token TokenLike
+	var ok bool
+	syntax, token, ok = v.parseSyntax()
 	if !ok {
-		var message = v.formatError(token, "Syntax")
-		panic(message)
+		v.reportError(token, "Syntax", "")
 	}
 
 	// Found the syntax.
-	return syntax
+	return syntax, errors
 }
 
 // Private
@@ -100,14 +123,14 @@ func (v *parser_) parseAlternative() (
 	ok bool,
 ) {
 	var ruleFound_ bool
+	var start_ = len(v.parsed_) // The index of the first token in the rule.
 
 	// Attempt to parse a single "|" delimiter.
 	_, token, ok = v.parseDelimiter("|")
 	if !ok {
 		if ruleFound_ {
 			// Found a syntax error.
-			var message = v.formatError(token, "Alternative")
-			panic(message)
+			v.reportError(token, "Alternative", "")
 		} else {
 			// This is not a single alternative rule.
 			return alternative, token, false
@@ -121,8 +144,7 @@ func (v *parser_) parseAlternative() (
 	if !ok {
 		if ruleFound_ {
 			// Found a syntax error.
-			var message = v.formatError(token, "Alternative")
-			panic(message)
+			v.reportError(token, "Alternative", "")
 		} else {
 			// This is not a single alternative rule.
 			return alternative, token, false
@@ -132,7 +154,10 @@ func (v *parser_) parseAlternative() (
 
 	// Found a single alternative rule.
 	ruleFound_ = true
-	alternative = ast.Alternative().Make(option)
+	alternative = ast.Alternative().MakeWithSpan(
+		v.getSpan(start_),
+		option,
+	)
 	return alternative, token, ruleFound_
 }
 
@@ -141,12 +166,14 @@ func (v *parser_) parseCardinality() (
 	token TokenLike,
 	ok bool,
 ) {
+	var start_ = len(v.parsed_) // The index of the first token in the rule.
+
 	// Attempt to parse a single constrained rule.
 	var constrained ast.ConstrainedLike
 	constrained, token, ok = v.parseConstrained()
 	if ok {
 		// Found a single constrained cardinality.
-		cardinality = ast.Cardinality().Make(constrained)
+		cardinality = ast.Cardinality().MakeWithSpan(v.getSpan(start_), constrained)
 		return cardinality, token, true
 	}
 
@@ -155,7 +182,7 @@ func (v *parser_) parseCardinality() (
 	quantified, token, ok = v.parseQuantified()
 	if ok {
 		// Found a single quantified cardinality.
-		cardinality = ast.Cardinality().Make(quantified)
+		cardinality = ast.Cardinality().MakeWithSpan(v.getSpan(start_), quantified)
 		return cardinality, token, true
 	}
 
@@ -169,12 +196,14 @@ func (v *parser_) parseCharacter() (
 	token TokenLike,
 	ok bool,
 ) {
+	var start_ = len(v.parsed_) // The index of the first token in the rule.
+
 	// Attempt to parse a single explicit rule.
 	var explicit ast.ExplicitLike
 	explicit, token, ok = v.parseExplicit()
 	if ok {
 		// Found a single explicit character.
-		character = ast.Character().Make(explicit)
+		character = ast.Character().MakeWithSpan(v.getSpan(start_), explicit)
 		return character, token, true
 	}
 
@@ -183,7 +212,7 @@ func (v *parser_) parseCharacter() (
 	intrinsic, token, ok = v.parseToken(IntrinsicToken)
 	if ok {
 		// Found a single intrinsic character.
-		character = ast.Character().Make(intrinsic)
+		character = ast.Character().MakeWithSpan(v.getSpan(start_), intrinsic)
 		return character, token, true
 	}
 
@@ -197,12 +226,14 @@ func (v *parser_) parseConstrained() (
 	token TokenLike,
 	ok bool,
 ) {
+	var start_ = len(v.parsed_) // The index of the first token in the rule.
+
 	// Attempt to parse a single optional token.
 	var optional string
 	optional, token, ok = v.parseToken(OptionalToken)
 	if ok {
 		// Found a single optional constrained.
-		constrained = ast.Constrained().Make(optional)
+		constrained = ast.Constrained().MakeWithSpan(v.getSpan(start_), optional)
 		return constrained, token, true
 	}
 
@@ -211,7 +242,7 @@ func (v *parser_) parseConstrained() (
 	repeated, token, ok = v.parseToken(RepeatedToken)
 	if ok {
 		// Found a single repeated constrained.
-		constrained = ast.Constrained().Make(repeated)
+		constrained = ast.Constrained().MakeWithSpan(v.getSpan(start_), repeated)
 		return constrained, token, true
 	}
 
@@ -225,12 +256,23 @@ func (v *parser_) parseDefinition() (
 	token TokenLike,
 	ok bool,
 ) {
+	var start_ = len(v.parsed_) // The index of the first token in the rule.
+
 	// Attempt to parse a single multiline rule.
 	var multiline ast.MultilineLike
 	multiline, token, ok = v.parseMultiline()
 	if ok {
 		// Found a single multiline definition.
-		definition = ast.Definition().Make(multiline)
+		definition = ast.Definition().MakeWithSpan(v.getSpan(start_), multiline)
+		return definition, token, true
+	}
+
+	// Attempt to parse a single precedence rule.
+	var precedence ast.PrecedenceLike
+	precedence, token, ok = v.parsePrecedence()
+	if ok {
+		// Found a single precedence definition.
+		definition = ast.Definition().MakeWithSpan(v.getSpan(start_), precedence)
 		return definition, token, true
 	}
 
@@ -239,7 +281,7 @@ func (v *parser_) parseDefinition() (
 	inline, token, ok = v.parseInline()
 	if ok {
 		// Found a single inline definition.
-		definition = ast.Definition().Make(inline)
+		definition = ast.Definition().MakeWithSpan(v.getSpan(start_), inline)
 		return definition, token, true
 	}
 
@@ -248,17 +290,97 @@ func (v *parser_) parseDefinition() (
 
 }
 
+func (v *parser_) parseDirective() (
+	directive ast.DirectiveLike,
+	token TokenLike,
+	ok bool,
+) {
+	var ruleFound_ bool
+	var start_ = len(v.parsed_) // The index of the first token in the rule.
+
+	// Attempt to parse a single "$" delimiter.
+	_, token, ok = v.parseDelimiter("$")
+	if !ok {
+		if ruleFound_ {
+			// Found a syntax error.
+			v.reportError(token, "Directive", "")
+		} else {
+			// This is not a single directive rule.
+			return directive, token, false
+		}
+	}
+	ruleFound_ = true
+
+	// Attempt to parse a single lowercase token.
+	var lowercase string
+	lowercase, token, ok = v.parseToken(LowercaseToken)
+	if !ok {
+		if ruleFound_ {
+			// Found a syntax error.
+			v.reportError(token, "Directive", "")
+		} else {
+			// This is not a single directive rule.
+			return directive, token, false
+		}
+	}
+	ruleFound_ = true
+
+	// Attempt to parse an optional note token.
+	var optionalNote string
+	optionalNote, _, ok = v.parseToken(NoteToken)
+	if ok {
+		ruleFound_ = true
+	}
+
+	// Attempt to parse 1 to unlimited newline tokens.
+	var newlines = col.List[string]()
+newlinesLoop:
+	for i := 0; i < unlimited; i++ {
+		var newline string
+		newline, token, ok = v.parseToken(NewlineToken)
+		if !ok {
+			switch {
+			case i < 1:
+				if !ruleFound_ {
+					// This is not a single directive rule.
+					return directive, token, false
+				}
+				// Found a syntax error.
+				v.reportError(token, "Directive", "Too few newline tokens found.")
+			case i > unlimited:
+				// Found a syntax error.
+				v.reportError(token, "Directive", "Too many newline tokens found.")
+			default:
+				break newlinesLoop
+			}
+		}
+		newlines.AppendValue(newline)
+	}
+
+	// Found a single directive rule.
+	ruleFound_ = true
+	directive = ast.Directive().MakeWithSpan(
+		v.getSpan(start_),
+		lowercase,
+		optionalNote,
+		newlines,
+	)
+	return directive, token, ruleFound_
+}
+
 func (v *parser_) parseElement() (
 	element ast.ElementLike,
 	token TokenLike,
 	ok bool,
 ) {
+	var start_ = len(v.parsed_) // The index of the first token in the rule.
+
 	// Attempt to parse a single group rule.
 	var group ast.GroupLike
 	group, token, ok = v.parseGroup()
 	if ok {
 		// Found a single group element.
-		element = ast.Element().Make(group)
+		element = ast.Element().MakeWithSpan(v.getSpan(start_), group)
 		return element, token, true
 	}
 
@@ -267,7 +389,7 @@ func (v *parser_) parseElement() (
 	filter, token, ok = v.parseFilter()
 	if ok {
 		// Found a single filter element.
-		element = ast.Element().Make(filter)
+		element = ast.Element().MakeWithSpan(v.getSpan(start_), filter)
 		return element, token, true
 	}
 
@@ -276,7 +398,7 @@ func (v *parser_) parseElement() (
 	text, token, ok = v.parseText()
 	if ok {
 		// Found a single text element.
-		element = ast.Element().Make(text)
+		element = ast.Element().MakeWithSpan(v.getSpan(start_), text)
 		return element, token, true
 	}
 
@@ -291,6 +413,7 @@ func (v *parser_) parseExplicit() (
 	ok bool,
 ) {
 	var ruleFound_ bool
+	var start_ = len(v.parsed_) // The index of the first token in the rule.
 
 	// Attempt to parse a single glyph token.
 	var glyph string
@@ -298,8 +421,7 @@ func (v *parser_) parseExplicit() (
 	if !ok {
 		if ruleFound_ {
 			// Found a syntax error.
-			var message = v.formatError(token, "Explicit")
-			panic(message)
+			v.reportError(token, "Explicit", "")
 		} else {
 			// This is not a single explicit rule.
 			return explicit, token, false
@@ -316,7 +438,8 @@ func (v *parser_) parseExplicit() (
 
 	// Found a single explicit rule.
 	ruleFound_ = true
-	explicit = ast.Explicit().Make(
+	explicit = ast.Explicit().MakeWithSpan(
+		v.getSpan(start_),
 		glyph,
 		optionalExtent,
 	)
@@ -329,6 +452,7 @@ func (v *parser_) parseExpression() (
 	ok bool,
 ) {
 	var ruleFound_ bool
+	var start_ = len(v.parsed_) // The index of the first token in the rule.
 
 	// Attempt to parse a single lowercase token.
 	var lowercase string
@@ -336,8 +460,7 @@ func (v *parser_) parseExpression() (
 	if !ok {
 		if ruleFound_ {
 			// Found a syntax error.
-			var message = v.formatError(token, "Expression")
-			panic(message)
+			v.reportError(token, "Expression", "")
 		} else {
 			// This is not a single expression rule.
 			return expression, token, false
@@ -350,8 +473,7 @@ func (v *parser_) parseExpression() (
 	if !ok {
 		if ruleFound_ {
 			// Found a syntax error.
-			var message = v.formatError(token, "Expression")
-			panic(message)
+			v.reportError(token, "Expression", "")
 		} else {
 			// This is not a single expression rule.
 			return expression, token, false
@@ -359,14 +481,20 @@ func (v *parser_) parseExpression() (
 	}
 	ruleFound_ = true
 
+	// Attempt to parse an optional caseless token.
+	var optionalCaseless string
+	optionalCaseless, _, ok = v.parseToken(CaselessToken)
+	if ok {
+		ruleFound_ = true
+	}
+
 	// Attempt to parse a single pattern rule.
 	var pattern ast.PatternLike
 	pattern, token, ok = v.parsePattern()
 	if !ok {
 		if ruleFound_ {
 			// Found a syntax error.
-			var message = v.formatError(token, "Expression")
-			panic(message)
+			v.reportError(token, "Expression", "")
 		} else {
 			// This is not a single expression rule.
 			return expression, token, false
@@ -374,6 +502,13 @@ func (v *parser_) parseExpression() (
 	}
 	ruleFound_ = true
 
+	// Attempt to parse an optional transition rule.
+	var optionalTransition ast.TransitionLike
+	optionalTransition, _, ok = v.parseTransition()
+	if ok {
+		ruleFound_ = true
+	}
+
 	// Attempt to parse an optional note token.
 	var optionalNote string
 	optionalNote, _, ok = v.parseToken(NoteToken)
@@ -395,14 +530,10 @@ newlinesLoop:
 					return expression, token, false
 				}
 				// Found a syntax error.
-				var message = v.formatError(token, "Expression")
-				message += "Too few newline tokens found."
-				panic(message)
+				v.reportError(token, "Expression", "Too few newline tokens found.")
 			case i > unlimited:
 				// Found a syntax error.
-				var message = v.formatError(token, "Expression")
-				message += "Too many newline tokens found."
-				panic(message)
+				v.reportError(token, "Expression", "Too many newline tokens found.")
 			default:
 				break newlinesLoop
 			}
@@ -412,9 +543,12 @@ newlinesLoop:
 
 	// Found a single expression rule.
 	ruleFound_ = true
-	expression = ast.Expression().Make(
+	expression = ast.Expression().MakeWithSpan(
+		v.getSpan(start_),
 		lowercase,
+		optionalCaseless,
 		pattern,
+		optionalTransition,
 		optionalNote,
 		newlines,
 	)
@@ -427,14 +561,14 @@ func (v *parser_) parseExtent() (
 	ok bool,
 ) {
 	var ruleFound_ bool
+	var start_ = len(v.parsed_) // The index of the first token in the rule.
 
 	// Attempt to parse a single ".." delimiter.
 	_, token, ok = v.parseDelimiter("..")
 	if !ok {
 		if ruleFound_ {
 			// Found a syntax error.
-			var message = v.formatError(token, "Extent")
-			panic(message)
+			v.reportError(token, "Extent", "")
 		} else {
 			// This is not a single extent rule.
 			return extent, token, false
@@ -448,8 +582,7 @@ func (v *parser_) parseExtent() (
 	if !ok {
 		if ruleFound_ {
 			// Found a syntax error.
-			var message = v.formatError(token, "Extent")
-			panic(message)
+			v.reportError(token, "Extent", "")
 		} else {
 			// This is not a single extent rule.
 			return extent, token, false
@@ -459,7 +592,10 @@ func (v *parser_) parseExtent() (
 
 	// Found a single extent rule.
 	ruleFound_ = true
-	extent = ast.Extent().Make(glyph)
+	extent = ast.Extent().MakeWithSpan(
+		v.getSpan(start_),
+		glyph,
+	)
 	return extent, token, ruleFound_
 }
 
@@ -469,6 +605,7 @@ func (v *parser_) parseFilter() (
 	ok bool,
 ) {
 	var ruleFound_ bool
+	var start_ = len(v.parsed_) // The index of the first token in the rule.
 
 	// Attempt to parse an optional excluded token.
 	var optionalExcluded string
@@ -482,8 +619,7 @@ func (v *parser_) parseFilter() (
 	if !ok {
 		if ruleFound_ {
 			// Found a syntax error.
-			var message = v.formatError(token, "Filter")
-			panic(message)
+			v.reportError(token, "Filter", "")
 		} else {
 			// This is not a single filter rule.
 			return filter, token, false
@@ -505,9 +641,7 @@ charactersLoop:
 					return filter, token, false
 				}
 				// Found a syntax error.
-				var message = v.formatError(token, "Filter")
-				message += "The number of character rules must be at least 1."
-				panic(message)
+				v.reportError(token, "Filter", "The number of character rules must be at least 1.")
 			default:
 				break charactersLoop
 			}
@@ -520,8 +654,7 @@ charactersLoop:
 	if !ok {
 		if ruleFound_ {
 			// Found a syntax error.
-			var message = v.formatError(token, "Filter")
-			panic(message)
+			v.reportError(token, "Filter", "")
 		} else {
 			// This is not a single filter rule.
 			return filter, token, false
@@ -531,7 +664,8 @@ charactersLoop:
 
 	// Found a single filter rule.
 	ruleFound_ = true
-	filter = ast.Filter().Make(
+	filter = ast.Filter().MakeWithSpan(
+		v.getSpan(start_),
 		optionalExcluded,
 		characters,
 	)
@@ -544,14 +678,14 @@ func (v *parser_) parseGroup() (
 	ok bool,
 ) {
 	var ruleFound_ bool
+	var start_ = len(v.parsed_) // The index of the first token in the rule.
 
 	// Attempt to parse a single "(" delimiter.
 	_, token, ok = v.parseDelimiter("(")
 	if !ok {
 		if ruleFound_ {
 			// Found a syntax error.
-			var message = v.formatError(token, "Group")
-			panic(message)
+			v.reportError(token, "Group", "")
 		} else {
 			// This is not a single group rule.
 			return group, token, false
@@ -565,8 +699,7 @@ func (v *parser_) parseGroup() (
 	if !ok {
 		if ruleFound_ {
 			// Found a syntax error.
-			var message = v.formatError(token, "Group")
-			panic(message)
+			v.reportError(token, "Group", "")
 		} else {
 			// This is not a single group rule.
 			return group, token, false
@@ -579,8 +712,7 @@ func (v *parser_) parseGroup() (
 	if !ok {
 		if ruleFound_ {
 			// Found a syntax error.
-			var message = v.formatError(token, "Group")
-			panic(message)
+			v.reportError(token, "Group", "")
 		} else {
 			// This is not a single group rule.
 			return group, token, false
@@ -590,7 +722,10 @@ func (v *parser_) parseGroup() (
 
 	// Found a single group rule.
 	ruleFound_ = true
-	group = ast.Group().Make(pattern)
+	group = ast.Group().MakeWithSpan(
+		v.getSpan(start_),
+		pattern,
+	)
 	return group, token, ruleFound_
 }
 
@@ -599,12 +734,14 @@ func (v *parser_) parseIdentifier() (
 	token TokenLike,
 	ok bool,
 ) {
+	var start_ = len(v.parsed_) // The index of the first token in the rule.
+
 	// Attempt to parse a single lowercase token.
 	var lowercase string
 	lowercase, token, ok = v.parseToken(LowercaseToken)
 	if ok {
 		// Found a single lowercase identifier.
-		identifier = ast.Identifier().Make(lowercase)
+		identifier = ast.Identifier().MakeWithSpan(v.getSpan(start_), lowercase)
 		return identifier, token, true
 	}
 
@@ -613,7 +750,7 @@ func (v *parser_) parseIdentifier() (
 	uppercase, token, ok = v.parseToken(UppercaseToken)
 	if ok {
 		// Found a single uppercase identifier.
-		identifier = ast.Identifier().Make(uppercase)
+		identifier = ast.Identifier().MakeWithSpan(v.getSpan(start_), uppercase)
 		return identifier, token, true
 	}
 
@@ -622,12 +759,91 @@ func (v *parser_) parseIdentifier() (
 
 }
 
+func (v *parser_) parseImport() (
+	import_ ast.ImportLike,
+	token TokenLike,
+	ok bool,
+) {
+	var ruleFound_ bool
+	var start_ = len(v.parsed_) // The index of the first token in the rule.
+
+	// Attempt to parse a single "@" delimiter.
+	_, token, ok = v.parseDelimiter("@")
+	if !ok {
+		if ruleFound_ {
+			// Found a syntax error.
+			v.reportError(token, "Import", "")
+		} else {
+			// This is not a single import rule.
+			return import_, token, false
+		}
+	}
+	ruleFound_ = true
+
+	// Attempt to parse a single literal token.
+	var literal string
+	literal, token, ok = v.parseToken(LiteralToken)
+	if !ok {
+		if ruleFound_ {
+			// Found a syntax error.
+			v.reportError(token, "Import", "")
+		} else {
+			// This is not a single import rule.
+			return import_, token, false
+		}
+	}
+	ruleFound_ = true
+
+	// Attempt to parse an optional note token.
+	var optionalNote string
+	optionalNote, _, ok = v.parseToken(NoteToken)
+	if ok {
+		ruleFound_ = true
+	}
+
+	// Attempt to parse 1 to unlimited newline tokens.
+	var newlines = col.List[string]()
+newlinesLoop:
+	for i := 0; i < unlimited; i++ {
+		var newline string
+		newline, token, ok = v.parseToken(NewlineToken)
+		if !ok {
+			switch {
+			case i < 1:
+				if !ruleFound_ {
+					// This is not a single import rule.
+					return import_, token, false
+				}
+				// Found a syntax error.
+				v.reportError(token, "Import", "Too few newline tokens found.")
+			case i > unlimited:
+				// Found a syntax error.
+				v.reportError(token, "Import", "Too many newline tokens found.")
+			default:
+				break newlinesLoop
+			}
+		}
+		newlines.AppendValue(newline)
+	}
+
+	// Found a single import rule.
+	ruleFound_ = true
+	import_ = ast.Import().MakeWithSpan(
+		v.getSpan(start_),
+		literal,
+		optionalNote,
+		newlines,
+	)
+	return import_, token, ruleFound_
+}
+
 func (v *parser_) parseInline() (
 	inline ast.InlineLike,
 	token TokenLike,
 	ok bool,
 ) {
 	var ruleFound_ bool
+	var start_ = len(v.parsed_) // The index of the first token in the rule.
 
 	// Attempt to parse 1 to unlimited term rules.
 	var terms = col.List[ast.TermLike]()
@@ -643,9 +859,7 @@ termsLoop:
 					return inline, token, false
 				}
 				// Found a syntax error.
-				var message = v.formatError(token, "Inline")
-				message += "The number of term rules must be at least 1."
-				panic(message)
+				v.reportError(token, "Inline", "The number of term rules must be at least 1.")
 			default:
 				break termsLoop
 			}
@@ -662,27 +876,160 @@ termsLoop:
 
 	// Found a single inline rule.
 	ruleFound_ = true
-	inline = ast.Inline().Make(
+	inline = ast.Inline().MakeWithSpan(
+		v.getSpan(start_),
 		terms,
 		optionalNote,
 	)
 	return inline, token, ruleFound_
 }
 
+func (v *parser_) parseLabel() (
+	label ast.LabelLike,
+	token TokenLike,
+	ok bool,
+) {
+	var ruleFound_ bool
+	var start_ = len(v.parsed_) // The index of the first token in the rule.
+
+	// Attempt to parse a single lowercase token.
+	var lowercase string
+	lowercase, token, ok = v.parseToken(LowercaseToken)
+	if !ok {
+		if ruleFound_ {
+			// Found a syntax error.
+			v.reportError(token, "Label", "")
+		} else {
+			// This is not a single label rule.
+			return label, token, false
+		}
+	}
+	ruleFound_ = true
+
+	// Look ahead for the next term without consuming it.
+	token, ok = v.lookAhead(func() (token TokenLike, ok bool) {
+		_, token, ok = v.parseDelimiter(":")
+		return token, ok
+	})
+	if !ok {
+		// This is not a single label rule so put back any tokens parsed for it.
+		v.putBackTokens(start_)
+		return label, token, false
+	}
+
+	// Attempt to parse a single ":" delimiter.
+	_, token, ok = v.parseDelimiter(":")
+	if !ok {
+		if ruleFound_ {
+			// Found a syntax error.
+			v.reportError(token, "Label", "")
+		} else {
+			// This is not a single label rule.
+			return label, token, false
+		}
+	}
+	ruleFound_ = true
+
+	// Found a single label rule.
+	ruleFound_ = true
+	label = ast.Label().MakeWithSpan(
+		v.getSpan(start_),
+		lowercase,
+	)
+	return label, token, ruleFound_
+}
+
+func (v *parser_) parseLevel() (
+	level ast.LevelLike,
+	token TokenLike,
+	ok bool,
+) {
+	var ruleFound_ bool
+	var start_ = len(v.parsed_) // The index of the first token in the rule.
+
+	// Attempt to parse a single associativity token.
+	var associativity string
+	associativity, token, ok = v.parseToken(AssociativityToken)
+	if !ok {
+		if ruleFound_ {
+			// Found a syntax error.
+			v.reportError(token, "Level", "")
+		} else {
+			// This is not a single level rule.
+			return level, token, false
+		}
+	}
+	ruleFound_ = true
+
+	// Attempt to parse 1 to unlimited operator rules.
+	var operators = col.List[ast.OperatorLike]()
+operatorsLoop:
+	for numberFound_ := 0; numberFound_ < unlimited; numberFound_++ {
+		var operator ast.OperatorLike
+		operator, token, ok = v.parseOperator()
+		if !ok {
+			switch {
+			case numberFound_ < 1:
+				if !ruleFound_ {
+					// This is not a single level rule.
+					return level, token, false
+				}
+				// Found a syntax error.
+				v.reportError(token, "Level", "The number of operator rules must be at least 1.")
+			default:
+				break operatorsLoop
+			}
+		}
+		operators.AppendValue(operator)
+	}
+
+	// Attempt to parse an optional note token.
+	var optionalNote string
+	optionalNote, _, ok = v.parseToken(NoteToken)
+	if ok {
+		ruleFound_ = true
+	}
+
+	// Attempt to parse a single newline token.
+	var newline string
+	newline, token, ok = v.parseToken(NewlineToken)
+	if !ok {
+		if ruleFound_ {
+			// Found a syntax error.
+			v.reportError(token, "Level", "")
+		} else {
+			// This is not a single level rule.
+			return level, token, false
+		}
+	}
+	ruleFound_ = true
+
+	// Found a single level rule.
+	ruleFound_ = true
+	level = ast.Level().MakeWithSpan(
+		v.getSpan(start_),
+		associativity,
+		operators,
+		optionalNote,
+		newline,
+	)
+	return level, token, ruleFound_
+}
+
 func (v *parser_) parseLimit() (
 	limit ast.LimitLike,
 	token TokenLike,
 	ok bool,
 ) {
 	var ruleFound_ bool
+	var start_ = len(v.parsed_) // The index of the first token in the rule.
 
 	// Attempt to parse a single ".." delimiter.
 	_, token, ok = v.parseDelimiter("..")
 	if !ok {
 		if ruleFound_ {
 			// Found a syntax error.
-			var message = v.formatError(token, "Limit")
-			panic(message)
+			v.reportError(token, "Limit", "")
 		} else {
 			// This is not a single limit rule.
 			return limit, token, false
@@ -699,7 +1046,10 @@ func (v *parser_) parseLimit() (
 
 	// Found a single limit rule.
 	ruleFound_ = true
-	limit = ast.Limit().Make(optionalNumber)
+	limit = ast.Limit().MakeWithSpan(
+		v.getSpan(start_),
+		optionalNumber,
+	)
 	return limit, token, ruleFound_
 }
 
@@ -709,14 +1059,14 @@ func (v *parser_) parseLine() (
 	ok bool,
 ) {
 	var ruleFound_ bool
+	var start_ = len(v.parsed_) // The index of the first token in the rule.
 
 	// Attempt to parse a single "-" delimiter.
 	_, token, ok = v.parseDelimiter("-")
 	if !ok {
 		if ruleFound_ {
 			// Found a syntax error.
-			var message = v.formatError(token, "Line")
-			panic(message)
+			v.reportError(token, "Line", "")
 		} else {
 			// This is not a single line rule.
 			return line, token, false
@@ -730,8 +1080,7 @@ func (v *parser_) parseLine() (
 	if !ok {
 		if ruleFound_ {
 			// Found a syntax error.
-			var message = v.formatError(token, "Line")
-			panic(message)
+			v.reportError(token, "Line", "")
 		} else {
 			// This is not a single line rule.
 			return line, token, false
@@ -752,8 +1101,7 @@ func (v *parser_) parseLine() (
 	if !ok {
 		if ruleFound_ {
 			// Found a syntax error.
-			var message = v.formatError(token, "Line")
-			panic(message)
+			v.reportError(token, "Line", "")
 		} else {
 			// This is not a single line rule.
 			return line, token, false
@@ -763,7 +1111,8 @@ func (v *parser_) parseLine() (
 
 	// Found a single line rule.
 	ruleFound_ = true
-	line = ast.Line().Make(
+	line = ast.Line().MakeWithSpan(
+		v.getSpan(start_),
 		identifier,
 		optionalNote,
 		newline,
@@ -771,46 +1120,204 @@ func (v *parser_) parseLine() (
 	return line, token, ruleFound_
 }
 
-func (v *parser_) parseMultiline() (
-	multiline ast.MultilineLike,
+func (v *parser_) parseLookahead() (
+	lookahead ast.LookaheadLike,
 	token TokenLike,
 	ok bool,
 ) {
 	var ruleFound_ bool
+	var start_ = len(v.parsed_) // The index of the first token in the rule.
 
-	// Attempt to parse a single newline token.
-	var newline string
-	newline, token, ok = v.parseToken(NewlineToken)
+	// Attempt to parse a single predicate token.
+	var predicate string
+	predicate, token, ok = v.parseToken(PredicateToken)
 	if !ok {
 		if ruleFound_ {
 			// Found a syntax error.
-			var message = v.formatError(token, "Multiline")
-			panic(message)
+			v.reportError(token, "Lookahead", "")
 		} else {
-			// This is not a single multiline rule.
-			return multiline, token, false
+			// This is not a single lookahead rule.
+			return lookahead, token, false
 		}
 	}
 	ruleFound_ = true
 
-	// Attempt to parse 1 to unlimited line rules.
-	var lines = col.List[ast.LineLike]()
-linesLoop:
-	for numberFound_ := 0; numberFound_ < unlimited; numberFound_++ {
-		var line ast.LineLike
-		line, token, ok = v.parseLine()
-		if !ok {
-			switch {
-			case numberFound_ < 1:
-				if !ruleFound_ {
-					// This is not a single multiline rule.
-					return multiline, token, false
-				}
-				// Found a syntax error.
-				var message = v.formatError(token, "Multiline")
-				message += "The number of line rules must be at least 1."
-				panic(message)
-			default:
+	// Attempt to parse a single term rule.
+	var term ast.TermLike
+	term, token, ok = v.parseTerm()
+	if !ok {
+		if ruleFound_ {
+			// Found a syntax error.
+			v.reportError(token, "Lookahead", "")
+		} else {
+			// This is not a single lookahead rule.
+			return lookahead, token, false
+		}
+	}
+	ruleFound_ = true
+
+	// Found a single lookahead rule.
+	ruleFound_ = true
+	lookahead = ast.Lookahead().MakeWithSpan(
+		v.getSpan(start_),
+		predicate,
+		term,
+	)
+	return lookahead, token, ruleFound_
+}
+
+func (v *parser_) parseMode() (
+	mode ast.ModeLike,
+	token TokenLike,
+	ok bool,
+) {
+	var ruleFound_ bool
+	var start_ = len(v.parsed_) // The index of the first token in the rule.
+
+	// Attempt to parse a single "[" delimiter.
+	_, token, ok = v.parseDelimiter("[")
+	if !ok {
+		if ruleFound_ {
+			// Found a syntax error.
+			v.reportError(token, "Mode", "")
+		} else {
+			// This is not a single mode rule.
+			return mode, token, false
+		}
+	}
+	ruleFound_ = true
+
+	// Attempt to parse a single lowercase token.
+	var lowercase string
+	lowercase, token, ok = v.parseToken(LowercaseToken)
+	if !ok {
+		if ruleFound_ {
+			// Found a syntax error.
+			v.reportError(token, "Mode", "")
+		} else {
+			// This is not a single mode rule.
+			return mode, token, false
+		}
+	}
+	ruleFound_ = true
+
+	// Attempt to parse a single "]" delimiter.
+	_, token, ok = v.parseDelimiter("]")
+	if !ok {
+		if ruleFound_ {
+			// Found a syntax error.
+			v.reportError(token, "Mode", "")
+		} else {
+			// This is not a single mode rule.
+			return mode, token, false
+		}
+	}
+	ruleFound_ = true
+
+	// Attempt to parse an optional note token.
+	var optionalNote string
+	optionalNote, _, ok = v.parseToken(NoteToken)
+	if ok {
+		ruleFound_ = true
+	}
+
+	// Attempt to parse 1 to unlimited newline tokens.
+	var newlines = col.List[string]()
+newlinesLoop:
+	for i := 0; i < unlimited; i++ {
+		var newline string
+		newline, token, ok = v.parseToken(NewlineToken)
+		if !ok {
+			switch {
+			case i < 1:
+				if !ruleFound_ {
+					// This is not a single mode rule.
+					return mode, token, false
+				}
+				// Found a syntax error.
+				v.reportError(token, "Mode", "Too few newline tokens found.")
+			case i > unlimited:
+				// Found a syntax error.
+				v.reportError(token, "Mode", "Too many newline tokens found.")
+			default:
+				break newlinesLoop
+			}
+		}
+		newlines.AppendValue(newline)
+	}
+
+	// Attempt to parse 1 to unlimited expression rules.
+	var expressions = col.List[ast.ExpressionLike]()
+expressionsLoop:
+	for numberFound_ := 0; numberFound_ < unlimited; numberFound_++ {
+		var expression ast.ExpressionLike
+		expression, token, ok = v.parseExpression()
+		if !ok {
+			switch {
+			case numberFound_ < 1:
+				if !ruleFound_ {
+					// This is not a single mode rule.
+					return mode, token, false
+				}
+				// Found a syntax error.
+				v.reportError(token, "Mode", "The number of expression rules must be at least 1.")
+			default:
+				break expressionsLoop
+			}
+		}
+		expressions.AppendValue(expression)
+	}
+
+	// Found a single mode rule.
+	ruleFound_ = true
+	mode = ast.Mode().MakeWithSpan(
+		v.getSpan(start_),
+		lowercase,
+		optionalNote,
+		newlines,
+		expressions,
+	)
+	return mode, token, ruleFound_
+}
+
+func (v *parser_) parseMultiline() (
+	multiline ast.MultilineLike,
+	token TokenLike,
+	ok bool,
+) {
+	var ruleFound_ bool
+	var start_ = len(v.parsed_) // The index of the first token in the rule.
+
+	// Attempt to parse a single newline token.
+	var newline string
+	newline, token, ok = v.parseToken(NewlineToken)
+	if !ok {
+		if ruleFound_ {
+			// Found a syntax error.
+			v.reportError(token, "Multiline", "")
+		} else {
+			// This is not a single multiline rule.
+			return multiline, token, false
+		}
+	}
+	ruleFound_ = true
+
+	// Attempt to parse 1 to unlimited line rules.
+	var lines = col.List[ast.LineLike]()
+linesLoop:
+	for numberFound_ := 0; numberFound_ < unlimited; numberFound_++ {
+		var line ast.LineLike
+		line, token, ok = v.parseLine()
+		if !ok {
+			switch {
+			case numberFound_ < 1:
+				if !ruleFound_ {
+					// This is not a single multiline rule.
+					return multiline, token, false
+				}
+				// Found a syntax error.
+				v.reportError(token, "Multiline", "The number of line rules must be at least 1.")
+			default:
 				break linesLoop
 			}
 		}
@@ -819,7 +1326,8 @@ linesLoop:
 
 	// Found a single multiline rule.
 	ruleFound_ = true
-	multiline = ast.Multiline().Make(
+	multiline = ast.Multiline().MakeWithSpan(
+		v.getSpan(start_),
 		newline,
 		lines,
 	)
@@ -832,6 +1340,7 @@ func (v *parser_) parseNotice() (
 	ok bool,
 ) {
 	var ruleFound_ bool
+	var start_ = len(v.parsed_) // The index of the first token in the rule.
 
 	// Attempt to parse a single comment token.
 	var comment string
@@ -839,8 +1348,7 @@ func (v *parser_) parseNotice() (
 	if !ok {
 		if ruleFound_ {
 			// Found a syntax error.
-			var message = v.formatError(token, "Notice")
-			panic(message)
+			v.reportError(token, "Notice", "")
 		} else {
 			// This is not a single notice rule.
 			return notice, token, false
@@ -854,8 +1362,7 @@ func (v *parser_) parseNotice() (
 	if !ok {
 		if ruleFound_ {
 			// Found a syntax error.
-			var message = v.formatError(token, "Notice")
-			panic(message)
+			v.reportError(token, "Notice", "")
 		} else {
 			// This is not a single notice rule.
 			return notice, token, false
@@ -865,19 +1372,52 @@ func (v *parser_) parseNotice() (
 
 	// Found a single notice rule.
 	ruleFound_ = true
-	notice = ast.Notice().Make(
+	notice = ast.Notice().MakeWithSpan(
+		v.getSpan(start_),
 		comment,
 		newline,
 	)
 	return notice, token, ruleFound_
 }
 
+func (v *parser_) parseOperator() (
+	operator ast.OperatorLike,
+	token TokenLike,
+	ok bool,
+) {
+	var ruleFound_ bool
+	var start_ = len(v.parsed_) // The index of the first token in the rule.
+
+	// Attempt to parse a single literal token.
+	var literal string
+	literal, token, ok = v.parseToken(LiteralToken)
+	if !ok {
+		if ruleFound_ {
+			// Found a syntax error.
+			v.reportError(token, "Operator", "")
+		} else {
+			// This is not a single operator rule.
+			return operator, token, false
+		}
+	}
+	ruleFound_ = true
+
+	// Found a single operator rule.
+	ruleFound_ = true
+	operator = ast.Operator().MakeWithSpan(
+		v.getSpan(start_),
+		literal,
+	)
+	return operator, token, ruleFound_
+}
+
 func (v *parser_) parseOption() (
 	option ast.OptionLike,
 	token TokenLike,
 	ok bool,
 ) {
 	var ruleFound_ bool
+	var start_ = len(v.parsed_) // The index of the first token in the rule.
 
 	// Attempt to parse 1 to unlimited repetition rules.
 	var repetitions = col.List[ast.RepetitionLike]()
@@ -893,9 +1433,7 @@ repetitionsLoop:
 					return option, token, false
 				}
 				// Found a syntax error.
-				var message = v.formatError(token, "Option")
-				message += "The number of repetition rules must be at least 1."
-				panic(message)
+				v.reportError(token, "Option", "The number of repetition rules must be at least 1.")
 			default:
 				break repetitionsLoop
 			}
@@ -905,7 +1443,10 @@ repetitionsLoop:
 
 	// Found a single option rule.
 	ruleFound_ = true
-	option = ast.Option().Make(repetitions)
+	option = ast.Option().MakeWithSpan(
+		v.getSpan(start_),
+		repetitions,
+	)
 	return option, token, ruleFound_
 }
 
@@ -915,6 +1456,7 @@ func (v *parser_) parsePattern() (
 	ok bool,
 ) {
 	var ruleFound_ bool
+	var start_ = len(v.parsed_) // The index of the first token in the rule.
 
 	// Attempt to parse a single option rule.
 	var option ast.OptionLike
@@ -922,8 +1464,7 @@ func (v *parser_) parsePattern() (
 	if !ok {
 		if ruleFound_ {
 			// Found a syntax error.
-			var message = v.formatError(token, "Pattern")
-			panic(message)
+			v.reportError(token, "Pattern", "")
 		} else {
 			// This is not a single pattern rule.
 			return pattern, token, false
@@ -945,9 +1486,7 @@ alternativesLoop:
 					return pattern, token, false
 				}
 				// Found a syntax error.
-				var message = v.formatError(token, "Pattern")
-				message += "The number of alternative rules must be at least 0."
-				panic(message)
+				v.reportError(token, "Pattern", "The number of alternative rules must be at least 0.")
 			default:
 				break alternativesLoop
 			}
@@ -957,27 +1496,118 @@ alternativesLoop:
 
 	// Found a single pattern rule.
 	ruleFound_ = true
-	pattern = ast.Pattern().Make(
+	pattern = ast.Pattern().MakeWithSpan(
+		v.getSpan(start_),
 		option,
 		alternatives,
 	)
 	return pattern, token, ruleFound_
 }
 
+func (v *parser_) parsePrecedence() (
+	precedence ast.PrecedenceLike,
+	token TokenLike,
+	ok bool,
+) {
+	var ruleFound_ bool
+	var start_ = len(v.parsed_) // The index of the first token in the rule.
+
+	// Attempt to parse a single "%" delimiter.
+	_, token, ok = v.parseDelimiter("%")
+	if !ok {
+		if ruleFound_ {
+			// Found a syntax error.
+			v.reportError(token, "Precedence", "")
+		} else {
+			// This is not a single precedence rule.
+			return precedence, token, false
+		}
+	}
+	ruleFound_ = true
+
+	// Attempt to parse a single identifier rule.
+	var identifier ast.IdentifierLike
+	identifier, token, ok = v.parseIdentifier()
+	if !ok {
+		if ruleFound_ {
+			// Found a syntax error.
+			v.reportError(token, "Precedence", "")
+		} else {
+			// This is not a single precedence rule.
+			return precedence, token, false
+		}
+	}
+	ruleFound_ = true
+
+	// Attempt to parse an optional note token.
+	var optionalNote string
+	optionalNote, _, ok = v.parseToken(NoteToken)
+	if ok {
+		ruleFound_ = true
+	}
+
+	// Attempt to parse a single newline token.
+	var newline string
+	newline, token, ok = v.parseToken(NewlineToken)
+	if !ok {
+		if ruleFound_ {
+			// Found a syntax error.
+			v.reportError(token, "Precedence", "")
+		} else {
+			// This is not a single precedence rule.
+			return precedence, token, false
+		}
+	}
+	ruleFound_ = true
+
+	// Attempt to parse 1 to unlimited level rules.
+	var levels = col.List[ast.LevelLike]()
+levelsLoop:
+	for numberFound_ := 0; numberFound_ < unlimited; numberFound_++ {
+		var level ast.LevelLike
+		level, token, ok = v.parseLevel()
+		if !ok {
+			switch {
+			case numberFound_ < 1:
+				if !ruleFound_ {
+					// This is not a single precedence rule.
+					return precedence, token, false
+				}
+				// Found a syntax error.
+				v.reportError(token, "Precedence", "The number of level rules must be at least 1.")
+			default:
+				break levelsLoop
+			}
+		}
+		levels.AppendValue(level)
+	}
+
+	// Found a single precedence rule.
+	ruleFound_ = true
+	precedence = ast.Precedence().MakeWithSpan(
+		v.getSpan(start_),
+		identifier,
+		optionalNote,
+		newline,
+		levels,
+	)
+	return precedence, token, ruleFound_
+}
+
 func (v *parser_) parseQuantified() (
 	quantified ast.QuantifiedLike,
 	token TokenLike,
 	ok bool,
 ) {
 	var ruleFound_ bool
+	var start_ = len(v.parsed_) // The index of the first token in the rule.
 
 	// Attempt to parse a single "{" delimiter.
 	_, token, ok = v.parseDelimiter("{")
 	if !ok {
 		if ruleFound_ {
 			// Found a syntax error.
-			var message = v.formatError(token, "Quantified")
-			panic(message)
+			v.reportError(token, "Quantified", "")
 		} else {
 			// This is not a single quantified rule.
 			return quantified, token, false
@@ -991,8 +1621,7 @@ func (v *parser_) parseQuantified() (
 	if !ok {
 		if ruleFound_ {
 			// Found a syntax error.
-			var message = v.formatError(token, "Quantified")
-			panic(message)
+			v.reportError(token, "Quantified", "")
 		} else {
 			// This is not a single quantified rule.
 			return quantified, token, false
@@ -1012,8 +1641,7 @@ func (v *parser_) parseQuantified() (
 	if !ok {
 		if ruleFound_ {
 			// Found a syntax error.
-			var message = v.formatError(token, "Quantified")
-			panic(message)
+			v.reportError(token, "Quantified", "")
 		} else {
 			// This is not a single quantified rule.
 			return quantified, token, false
@@ -1023,7 +1651,8 @@ func (v *parser_) parseQuantified() (
 
 	// Found a single quantified rule.
 	ruleFound_ = true
-	quantified = ast.Quantified().Make(
+	quantified = ast.Quantified().MakeWithSpan(
+		v.getSpan(start_),
 		number,
 		optionalLimit,
 	)
@@ -1036,6 +1665,14 @@ func (v *parser_) parseReference() (
 	ok bool,
 ) {
 	var ruleFound_ bool
+	var start_ = len(v.parsed_) // The index of the first token in the rule.
+
+	// Attempt to parse an optional label rule.
+	var optionalLabel ast.LabelLike
+	optionalLabel, _, ok = v.parseLabel()
+	if ok {
+		ruleFound_ = true
+	}
 
 	// Attempt to parse a single identifier rule.
 	var identifier ast.IdentifierLike
@@ -1043,8 +1680,7 @@ func (v *parser_) parseReference() (
 	if !ok {
 		if ruleFound_ {
 			// Found a syntax error.
-			var message = v.formatError(token, "Reference")
-			panic(message)
+			v.reportError(token, "Reference", "")
 		} else {
 			// This is not a single reference rule.
 			return reference, token, false
@@ -1059,11 +1695,21 @@ func (v *parser_) parseReference() (
 		ruleFound_ = true
 	}
 
+	// Attempt to parse an optional separator rule.
+	var optionalSeparator ast.SeparatorLike
+	optionalSeparator, _, ok = v.parseSeparator()
+	if ok {
+		ruleFound_ = true
+	}
+
 	// Found a single reference rule.
 	ruleFound_ = true
-	reference = ast.Reference().Make(
+	reference = ast.Reference().MakeWithSpan(
+		v.getSpan(start_),
+		optionalLabel,
 		identifier,
 		optionalCardinality,
+		optionalSeparator,
 	)
 	return reference, token, ruleFound_
 }
@@ -1074,6 +1720,7 @@ func (v *parser_) parseRepetition() (
 	ok bool,
 ) {
 	var ruleFound_ bool
+	var start_ = len(v.parsed_) // The index of the first token in the rule.
 
 	// Attempt to parse a single element rule.
 	var element ast.ElementLike
@@ -1081,8 +1728,7 @@ func (v *parser_) parseRepetition() (
 	if !ok {
 		if ruleFound_ {
 			// Found a syntax error.
-			var message = v.formatError(token, "Repetition")
-			panic(message)
+			v.reportError(token, "Repetition", "")
 		} else {
 			// This is not a single repetition rule.
 			return repetition, token, false
@@ -1099,7 +1745,8 @@ func (v *parser_) parseRepetition() (
 
 	// Found a single repetition rule.
 	ruleFound_ = true
-	repetition = ast.Repetition().Make(
+	repetition = ast.Repetition().MakeWithSpan(
+		v.getSpan(start_),
 		element,
 		optionalCardinality,
 	)
@@ -1112,6 +1759,7 @@ func (v *parser_) parseRule() (
 	ok bool,
 ) {
 	var ruleFound_ bool
+	var start_ = len(v.parsed_) // The index of the first token in the rule.
 
 	// Attempt to parse a single uppercase token.
 	var uppercase string
@@ -1119,8 +1767,7 @@ func (v *parser_) parseRule() (
 	if !ok {
 		if ruleFound_ {
 			// Found a syntax error.
-			var message = v.formatError(token, "Rule")
-			panic(message)
+			v.reportError(token, "Rule", "")
 		} else {
 			// This is not a single rule rule.
 			return rule, token, false
@@ -1133,8 +1780,7 @@ func (v *parser_) parseRule() (
 	if !ok {
 		if ruleFound_ {
 			// Found a syntax error.
-			var message = v.formatError(token, "Rule")
-			panic(message)
+			v.reportError(token, "Rule", "")
 		} else {
 			// This is not a single rule rule.
 			return rule, token, false
@@ -1148,8 +1794,7 @@ func (v *parser_) parseRule() (
 	if !ok {
 		if ruleFound_ {
 			// Found a syntax error.
-			var message = v.formatError(token, "Rule")
-			panic(message)
+			v.reportError(token, "Rule", "")
 		} else {
 			// This is not a single rule rule.
 			return rule, token, false
@@ -1171,14 +1816,10 @@ newlinesLoop:
 					return rule, token, false
 				}
 				// Found a syntax error.
-				var message = v.formatError(token, "Rule")
-				message += "Too few newline tokens found."
-				panic(message)
+				v.reportError(token, "Rule", "Too few newline tokens found.")
 			case i > unlimited:
 				// Found a syntax error.
-				var message = v.formatError(token, "Rule")
-				message += "Too many newline tokens found."
-				panic(message)
+				v.reportError(token, "Rule", "Too many newline tokens found.")
 			default:
 				break newlinesLoop
 			}
@@ -1188,7 +1829,8 @@ newlinesLoop:
 
 	// Found a single rule rule.
 	ruleFound_ = true
-	rule = ast.Rule().Make(
+	rule = ast.Rule().MakeWithSpan(
+		v.getSpan(start_),
 		uppercase,
 		definition,
 		newlines,
@@ -1196,12 +1838,65 @@ newlinesLoop:
 	return rule, token, ruleFound_
 }
 
+func (v *parser_) parseSeparator() (
+	separator ast.SeparatorLike,
+	token TokenLike,
+	ok bool,
+) {
+	var ruleFound_ bool
+	var start_ = len(v.parsed_) // The index of the first token in the rule.
+
+	// Attempt to parse a single "/" delimiter.
+	_, token, ok = v.parseDelimiter("/")
+	if !ok {
+		if ruleFound_ {
+			// Found a syntax error.
+			v.reportError(token, "Separator", "")
+		} else {
+			// This is not a single separator rule.
+			return separator, token, false
+		}
+	}
+	ruleFound_ = true
+
+	// Attempt to parse a single literal token.
+	var literal string
+	literal, token, ok = v.parseToken(LiteralToken)
+	if !ok {
+		if ruleFound_ {
+			// Found a syntax error.
+			v.reportError(token, "Separator", "")
+		} else {
+			// This is not a single separator rule.
+			return separator, token, false
+		}
+	}
+	ruleFound_ = true
+
+	// Attempt to parse an optional optional token.
+	var optionalTrailing string
+	optionalTrailing, _, ok = v.parseToken(OptionalToken)
+	if ok {
+		ruleFound_ = true
+	}
+
+	// Found a single separator rule.
+	ruleFound_ = true
+	separator = ast.Separator().MakeWithSpan(
+		v.getSpan(start_),
+		literal,
+		optionalTrailing,
+	)
+	return separator, token, ruleFound_
+}
+
 func (v *parser_) parseSyntax() (
 	syntax ast.SyntaxLike,
 	token TokenLike,
 	ok bool,
 ) {
 	var ruleFound_ bool
+	var start_ = len(v.parsed_) // The index of the first token in the rule.
 
 	// Attempt to parse a single notice rule.
 	var notice ast.NoticeLike
@@ -1209,8 +1904,7 @@ func (v *parser_) parseSyntax() (
 	if !ok {
 		if ruleFound_ {
 			// Found a syntax error.
-			var message = v.formatError(token, "Syntax")
-			panic(message)
+			v.reportError(token, "Syntax", "")
 		} else {
 			// This is not a single syntax rule.
 			return syntax, token, false
@@ -1218,14 +1912,63 @@ func (v *parser_) parseSyntax() (
 	}
 	ruleFound_ = true
 
+	// Attempt to parse 0 to unlimited directive rules.
+	var directives = col.List[ast.DirectiveLike]()
+directivesLoop:
+	for numberFound_ := 0; numberFound_ < unlimited; numberFound_++ {
+		var directive ast.DirectiveLike
+		if v.attemptRecovery("Directive", func() { directive, token, ok = v.parseDirective() }) {
+			// Skip over the invalid directive rule and continue parsing.
+			continue
+		}
+		if !ok {
+			switch {
+			case numberFound_ < 0:
+				if !ruleFound_ {
+					// This is not a single syntax rule.
+					return syntax, token, false
+				}
+				// Found a syntax error.
+				v.reportError(token, "Syntax", "The number of directive rules must be at least 0.")
+			default:
+				break directivesLoop
+			}
+		}
+		directives.AppendValue(directive)
+	}
+
+	// Attempt to parse 0 to unlimited import rules.
+	var imports = col.List[ast.ImportLike]()
+importsLoop:
+	for numberFound_ := 0; numberFound_ < unlimited; numberFound_++ {
+		var import_ ast.ImportLike
+		if v.attemptRecovery("Import", func() { import_, token, ok = v.parseImport() }) {
+			// Skip over the invalid import rule and continue parsing.
+			continue
+		}
+		if !ok {
+			switch {
+			case numberFound_ < 0:
+				if !ruleFound_ {
+					// This is not a single syntax rule.
+					return syntax, token, false
+				}
+				// Found a syntax error.
+				v.reportError(token, "Syntax", "The number of import rules must be at least 0.")
+			default:
+				break importsLoop
+			}
+		}
+		imports.AppendValue(import_)
+	}
+
 	// Attempt to parse a single comment token.
-	var comment1 string
-	comment1, token, ok = v.parseToken(CommentToken)
+	var ruleHeader string
+	ruleHeader, token, ok = v.parseToken(CommentToken)
 	if !ok {
 		if ruleFound_ {
 			// Found a syntax error.
-			var message = v.formatError(token, "Syntax")
-			panic(message)
+			v.reportError(token, "Syntax", "")
 		} else {
 			// This is not a single syntax rule.
 			return syntax, token, false
@@ -1233,23 +1976,31 @@ func (v *parser_) parseSyntax() (
 	}
 	ruleFound_ = true
 
-	// Attempt to parse 1 to unlimited rule rules.
+	// Attempt to parse an optional newline token.
+	var optionalNewline string
+	optionalNewline, _, ok = v.parseToken(NewlineToken)
+	if ok {
+		ruleFound_ = true
+	}
+
+	// Attempt to parse 0 to unlimited rule rules.
 	var rules = col.List[ast.RuleLike]()
 rulesLoop:
 	for numberFound_ := 0; numberFound_ < unlimited; numberFound_++ {
 		var rule ast.RuleLike
-		rule, token, ok = v.parseRule()
+		if v.attemptRecovery("Rule", func() { rule, token, ok = v.parseRule() }) {
+			// Skip over the invalid rule rule and continue parsing.
+			continue
+		}
 		if !ok {
 			switch {
-			case numberFound_ < 1:
+			case numberFound_ < 0:
 				if !ruleFound_ {
 					// This is not a single syntax rule.
 					return syntax, token, false
 				}
 				// Found a syntax error.
-				var message = v.formatError(token, "Syntax")
-				message += "The number of rule rules must be at least 1."
-				panic(message)
+				v.reportError(token, "Syntax", "The number of rule rules must be at least 0.")
 			default:
 				break rulesLoop
 			}
@@ -1258,13 +2009,12 @@ rulesLoop:
 	}
 
 	// Attempt to parse a single comment token.
-	var comment2 string
-	comment2, token, ok = v.parseToken(CommentToken)
+	var expressionHeader string
+	expressionHeader, token, ok = v.parseToken(CommentToken)
 	if !ok {
 		if ruleFound_ {
 			// Found a syntax error.
-			var message = v.formatError(token, "Syntax")
-			panic(message)
+			v.reportError(token, "Syntax", "")
 		} else {
 			// This is not a single syntax rule.
 			return syntax, token, false
@@ -1272,23 +2022,24 @@ rulesLoop:
 	}
 	ruleFound_ = true
 
-	// Attempt to parse 1 to unlimited expression rules.
+	// Attempt to parse 0 to unlimited expression rules.
 	var expressions = col.List[ast.ExpressionLike]()
 expressionsLoop:
 	for numberFound_ := 0; numberFound_ < unlimited; numberFound_++ {
 		var expression ast.ExpressionLike
-		expression, token, ok = v.parseExpression()
+		if v.attemptRecovery("Expression", func() { expression, token, ok = v.parseExpression() }) {
+			// Skip over the invalid expression rule and continue parsing.
+			continue
+		}
 		if !ok {
 			switch {
-			case numberFound_ < 1:
+			case numberFound_ < 0:
 				if !ruleFound_ {
 					// This is not a single syntax rule.
 					return syntax, token, false
 				}
 				// Found a syntax error.
-				var message = v.formatError(token, "Syntax")
-				message += "The number of expression rules must be at least 1."
-				panic(message)
+				v.reportError(token, "Syntax", "The number of expression rules must be at least 0.")
 			default:
 				break expressionsLoop
 			}
@@ -1296,14 +2047,44 @@ expressionsLoop:
 		expressions.AppendValue(expression)
 	}
 
+	// Attempt to parse 0 to unlimited mode rules.
+	var modes = col.List[ast.ModeLike]()
+modesLoop:
+	for numberFound_ := 0; numberFound_ < unlimited; numberFound_++ {
+		var mode ast.ModeLike
+		if v.attemptRecovery("Mode", func() { mode, token, ok = v.parseMode() }) {
+			// Skip over the invalid mode rule and continue parsing.
+			continue
+		}
+		if !ok {
+			switch {
+			case numberFound_ < 0:
+				if !ruleFound_ {
+					// This is not a single syntax rule.
+					return syntax, token, false
+				}
+				// Found a syntax error.
+				v.reportError(token, "Syntax", "The number of mode rules must be at least 0.")
+			default:
+				break modesLoop
+			}
+		}
+		modes.AppendValue(mode)
+	}
+
 	// Found a single syntax rule.
 	ruleFound_ = true
-	syntax = ast.Syntax().Make(
+	syntax = ast.Syntax().MakeWithSpan(
+		v.getSpan(start_),
 		notice,
-		comment1,
+		directives,
+		imports,
+		ruleHeader,
+		optionalNewline,
 		rules,
-		comment2,
+		expressionHeader,
 		expressions,
+		modes,
 	)
 	return syntax, token, ruleFound_
 }
@@ -1313,12 +2094,23 @@ func (v *parser_) parseTerm() (
 	token TokenLike,
 	ok bool,
 ) {
+	var start_ = len(v.parsed_) // The index of the first token in the rule.
+
+	// Attempt to parse a single lookahead rule.
+	var lookahead ast.LookaheadLike
+	lookahead, token, ok = v.parseLookahead()
+	if ok {
+		// Found a single lookahead term.
+		term = ast.Term().MakeWithSpan(v.getSpan(start_), lookahead)
+		return term, token, true
+	}
+
 	// Attempt to parse a single reference rule.
 	var reference ast.ReferenceLike
 	reference, token, ok = v.parseReference()
 	if ok {
 		// Found a single reference term.
-		term = ast.Term().Make(reference)
+		term = ast.Term().MakeWithSpan(v.getSpan(start_), reference)
 		return term, token, true
 	}
 
@@ -1327,7 +2119,7 @@ func (v *parser_) parseTerm() (
 	literal, token, ok = v.parseToken(LiteralToken)
 	if ok {
 		// Found a single literal term.
-		term = ast.Term().Make(literal)
+		term = ast.Term().MakeWithSpan(v.getSpan(start_), literal)
 		return term, token, true
 	}
 
@@ -1341,12 +2133,14 @@ func (v *parser_) parseText() (
 	token TokenLike,
 	ok bool,
 ) {
+	var start_ = len(v.parsed_) // The index of the first token in the rule.
+
 	// Attempt to parse a single intrinsic token.
 	var intrinsic string
 	intrinsic, token, ok = v.parseToken(IntrinsicToken)
 	if ok {
 		// Found a single intrinsic text.
-		text = ast.Text().Make(intrinsic)
+		text = ast.Text().MakeWithSpan(v.getSpan(start_), intrinsic)
 		return text, token, true
 	}
 
@@ -1355,7 +2149,7 @@ func (v *parser_) parseText() (
 	glyph, token, ok = v.parseToken(GlyphToken)
 	if ok {
 		// Found a single glyph text.
-		text = ast.Text().Make(glyph)
+		text = ast.Text().MakeWithSpan(v.getSpan(start_), glyph)
 		return text, token, true
 	}
 
@@ -1364,7 +2158,7 @@ func (v *parser_) parseText() (
 	literal, token, ok = v.parseToken(LiteralToken)
 	if ok {
 		// Found a single literal text.
-		text = ast.Text().Make(literal)
+		text = ast.Text().MakeWithSpan(v.getSpan(start_), literal)
 		return text, token, true
 	}
 
@@ -1373,7 +2167,7 @@ func (v *parser_) parseText() (
 	lowercase, token, ok = v.parseToken(LowercaseToken)
 	if ok {
 		// Found a single lowercase text.
-		text = ast.Text().Make(lowercase)
+		text = ast.Text().MakeWithSpan(v.getSpan(start_), lowercase)
 		return text, token, true
 	}
 
@@ -1382,6 +2176,43 @@ func (v *parser_) parseText() (
 
 }
 
+func (v *parser_) parseTransition() (
+	transition ast.TransitionLike,
+	token TokenLike,
+	ok bool,
+) {
+	var ruleFound_ bool
+	var start_ = len(v.parsed_) // The index of the first token in the rule.
+
+	// Attempt to parse a single "->" delimiter.
+	_, token, ok = v.parseDelimiter("->")
+	if !ok {
+		if ruleFound_ {
+			// Found a syntax error.
+			v.reportError(token, "Transition", "")
+		} else {
+			// This is not a single transition rule.
+			return transition, token, false
+		}
+	}
+	ruleFound_ = true
+
+	// Attempt to parse an optional lowercase token.
+	var optionalMode string
+	optionalMode, _, ok = v.parseToken(LowercaseToken)
+	if ok {
+		ruleFound_ = true
+	}
+
+	// Found a single transition rule.
+	ruleFound_ = true
+	transition = ast.Transition().MakeWithSpan(
+		v.getSpan(start_),
+		optionalMode,
+	)
+	return transition, token, ruleFound_
+}
+
 func (v *parser_) parseDelimiter(expectedValue string) (
 	value string,
 	token TokenLike,
@@ -1390,10 +2221,11 @@ func (v *parser_) parseDelimiter(expectedValue string) (
 	// Attempt to parse a single delimiter.
 	value, token, ok = v.parseToken(DelimiterToken)
 	if ok {
-		if value == expectedValue {
+		if value == expectedValue || v.foldDelimiter(value) == expectedValue {
 			// Found the right delimiter.
 			return value, token, true
 		}
+		v.parsed_ = v.parsed_[:len(v.parsed_)-1]
 		v.putBack(token)
 	}
 
@@ -1401,6 +2233,26 @@ func (v *parser_) parseDelimiter(expectedValue string) (
 	return value, token, false
 }
 
+func (v *parser_) parseKeyword(expectedValue string) (
+	value string,
+	token TokenLike,
+	ok bool,
+) {
+	// Attempt to parse a single keyword.
+	value, token, ok = v.parseToken(KeywordToken)
+	if ok {
+		if value == expectedValue || v.foldDelimiter(value) == expectedValue {
+			// Found the right keyword.
+			return value, token, true
+		}
+		v.parsed_ = v.parsed_[:len(v.parsed_)-1]
+		v.putBack(token)
+	}
+
+	// This is not the right keyword.
+	return value, token, false
+}
+
 func (v *parser_) parseToken(tokenType TokenType) (
 	value string,
 	token TokenLike,
@@ -1414,8 +2266,9 @@ func (v *parser_) parseToken(tokenType TokenType) (
 		case tokenType:
 			// Found the right token type.
 			value = token.GetValue()
+			v.parsed_ = append(v.parsed_, token)
 			return value, token, true
-		case SpaceToken, NewlineToken:
+		case SpaceToken:
 			// Ignore any unspecified whitespace.
 			token = v.getNextToken()
 		default:
@@ -1429,13 +2282,54 @@ func (v *parser_) parseToken(tokenType TokenType) (
 	return value, token, false
 }
 
-func (v *parser_) formatError(token TokenLike, ruleName string) string {
+func (v *parser_) attemptRecovery(ruleName string, parse func()) (recovered bool) {
+	var start = len(v.parsed_)
+	defer func() {
+		var result = recover()
+		if result == nil {
+			// No syntax error was found.
+			return
+		}
+		var error_, ok = result.(ParseErrorLike)
+		if !ok {
+			// This is not a syntax error so pass it on.
+			panic(result)
+		}
+		v.errors_.AppendValue(error_)
+		var token = error_.GetToken()
+		if len(v.parsed_) == start && token != nil && token.GetType() != ErrorToken {
+			// Skip over the token that could not be parsed so that parsing progresses.
+			v.readToken()
+		}
+		v.synchronize(ruleName)
+		recovered = true
+	}()
+	parse()
+	return recovered
+}
+
+func (v *parser_) foldDelimiter(value string) string {
+	// A caseless delimiter matches its value without regard to case.
+	var iterator = caselessDelimiters_.GetIterator()
+	for iterator.HasNext() {
+		var delimiter = iterator.GetNext()
+		if sts.EqualFold(value, delimiter) {
+			return delimiter
+		}
+	}
+	return value
+}
+
+func (v *parser_) formatError(error_ ParseErrorLike) string {
 	// Format the error message.
-	var message = fmt.Sprintf(
-		"An unexpected token was received by the parser: %v\n",
-		Scanner().FormatToken(token),
-	)
-	var line = token.GetLine()
+	var message = "An unexpected token was received by the parser: "
+	var token = error_.GetToken()
+	if token == nil {
+		message += "<EOF>\n"
+	} else {
+		message += Scanner().FormatToken(token) + "\n"
+	}
+	var line = error_.GetLine()
 	var lines = sts.Split(v.source_, "\n")
 
 	// Append the source line with the error in it.
@@ -1448,7 +2342,7 @@ func (v *parser_) formatError(token TokenLike, ruleName string) string {
 	// Append an arrow pointing to the error.
 	message += " \033[32m>>>─"
 	var count uint
-	for count < token.GetPosition() {
+	for count < error_.GetPosition() {
 		message += "─"
 		count++
 	}
@@ -1459,14 +2353,16 @@ func (v *parser_) formatError(token TokenLike, ruleName string) string {
 		message += fmt.Sprintf("%04d: ", line+1) + string(lines[line]) + "\n"
 	}
 	message += "\033[0m\n"
+	var ruleName = error_.GetRuleName()
 	if col.IsDefined(ruleName) {
 		message += "Was expecting:\n"
 		message += fmt.Sprintf(
 			"  \033[32m%v: \033[33m%v\033[0m\n\n",
 			ruleName,
-			v.getDefinition(ruleName),
+			error_.GetExpected(),
 		)
 	}
+	message += error_.GetMessage()
 	return message
 }
 
@@ -1474,7 +2370,123 @@ func (v *parser_) getDefinition(ruleName string) string {
 	return syntax_.GetValue(ruleName)
 }
 
+func (v *parser_) getEnd(token TokenLike) ast.PositionLike {
+	// Find the text of the token in the source code.
+	var offset = token.GetOffset()
+	var text = token.GetValue()
+	if !sts.HasPrefix(v.source_[offset:], text) {
+		// The token value is the name of a single control character.
+		var _, size = utf.DecodeRuneInString(v.source_[offset:])
+		text = v.source_[offset : offset+uint(size)]
+	}
+
+	// The end position follows the last character of the token.
+	var line = token.GetLine()
+	var column = token.GetPosition()
+	var index = sts.LastIndex(text, "\n")
+	if index < 0 {
+		column += uint(utf.RuneCountInString(text))
+	} else {
+		line += uint(sts.Count(text, "\n"))
+		column = uint(utf.RuneCountInString(text[index+1:])) + 1
+	}
+	return ast.Position().Make(line, column, offset+uint(len(text)))
+}
+
 func (v *parser_) getNextToken() TokenLike {
+	// Read the next token.
+	var token = v.readToken()
+
+	// Check for an error token.
+	if token != nil && token.GetType() == ErrorToken {
+		var message = "An unrecognized character was found."
+		v.reportError(token, "", message)
+	}
+
+	return token
+}
+
+func (v *parser_) getSpan(start int) ast.SpanLike {
+	// The span covers the tokens that were parsed for the node.
+	var end = len(v.parsed_) - 1
+	if end < start {
+		// No tokens were parsed for the node.
+		return nil
+	}
+	var span = ast.Span().Make(
+		v.getStart(v.parsed_[start]),
+		v.getEnd(v.parsed_[end]),
+	)
+	return span
+}
+
+func (v *parser_) getStart(token TokenLike) ast.PositionLike {
+	return ast.Position().Make(
+		token.GetLine(),
+		token.GetPosition(),
+		token.GetOffset(),
+	)
+}
+
+func (v *parser_) lookAhead(parse func() (TokenLike, bool)) (
+	token TokenLike,
+	ok bool,
+) {
+	// Put back every token that is parsed while looking ahead.
+	var start = len(v.parsed_)
+	defer func() {
+		var result = recover()
+		if result != nil {
+			var error_, isError = result.(ParseErrorLike)
+			if !isError {
+				// This is not a syntax error so pass it on.
+				panic(result)
+			}
+
+			// A syntax error means that the term was not found.
+			token = error_.GetToken()
+			if token != nil && token.GetType() == ErrorToken {
+				v.putBack(token) // It was not put back when it was reported.
+			}
+			ok = false
+		}
+		v.putBackTokens(start)
+	}()
+	token, ok = parse()
+	return token, ok
+}
+
+func (v *parser_) matchesAny(token TokenLike, names []string) bool {
+	// Each name is either a literal delimiter or the name of a token type.
+	var tokenType = token.GetType()
+	for _, name := range names {
+		switch tokenType {
+		case DelimiterToken, KeywordToken:
+			if name == "\""+v.foldDelimiter(token.GetValue())+"\"" {
+				return true
+			}
+		default:
+			if name == Scanner().FormatType(tokenType) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (v *parser_) putBack(token TokenLike) {
+	v.next_.AddValue(token)
+}
+
+func (v *parser_) putBackTokens(start int) {
+	// Put back every token that was parsed since the start index, last first.
+	for index := len(v.parsed_) - 1; index >= start; index-- {
+		v.putBack(v.parsed_[index])
+	}
+	v.parsed_ = v.parsed_[:start]
+}
+
+func (v *parser_) readToken() TokenLike {
 	// Check for any read, but unprocessed tokens.
 	if !v.next_.IsEmpty() {
 		return v.next_.RemoveTop()
@@ -1487,17 +2499,65 @@ func (v *parser_) getNextToken() TokenLike {
 		return nil
 	}
 
-	// Check for an error token.
-	if token.GetType() == ErrorToken {
-		var message = v.formatError(token, "")
-		panic(message)
+	return token
+}
+
+func (v *parser_) recoverError() {
+	var result = recover()
+	if result == nil {
+		// No syntax error was found.
+		return
+	}
+	var error_, ok = result.(ParseErrorLike)
+	if !ok {
+		// This is not a syntax error so pass it on.
+		panic(result)
 	}
+	v.errors_.AppendValue(error_)
+}
 
-	return token
+func (v *parser_) reportError(token TokenLike, ruleName string, message string) {
+	// Locate the syntax error.
+	var line, position uint
+	if token != nil {
+		line = token.GetLine()
+		position = token.GetPosition()
+	} else {
+		// The end of the source was reached.
+		var lines = sts.Split(v.source_, "\n")
+		line = uint(len(lines))
+		position = uint(len([]rune(lines[line-1]))) + 1
+	}
+
+	// Abort the parsing with the syntax error.
+	var expected string
+	if col.IsDefined(ruleName) {
+		expected = v.getDefinition(ruleName)
+	}
+	var error_ = ParseError().Make(
+		line,
+		position,
+		token,
+		ruleName,
+		expected,
+		message,
+	)
+	panic(error_)
 }
 
-func (v *parser_) putBack(token TokenLike) {
-	v.next_.AddValue(token)
+func (v *parser_) synchronize(ruleName string) {
+	// Skip all tokens until one begins another rule or follows the rules.
+	var firsts = firsts_.GetValue(ruleName)
+	var follows = follows_.GetValue(ruleName)
+	var token = v.readToken()
+	for token != nil {
+		if v.matchesAny(token, firsts) || v.matchesAny(token, follows) {
+			// Resume parsing with this token, any new syntax error is reported.
+			v.putBack(token)
+			return
+		}
+		token = v.readToken()
+	}
 }
 
 // PRIVATE GLOBALS
@@ -1506,24 +2566,36 @@ func (v *parser_) putBack(token TokenLike) {
 
 const unlimited = 4294967295 // Default to a reasonable value.
 
+var caselessDelimiters_ = col.Set[string]([]string{})
+
 var syntax_ = col.Catalog[string, string](
 	map[string]string{
-		"Syntax": `Notice comment Rule+ comment Expression+`,
-		"Notice": `comment newline`,
-		"Rule":   `uppercase ":" Definition newline+`,
+		"Syntax":    `Notice Directive* Import* ruleHeader:comment newline? Rule* expressionHeader:comment Expression* Mode*`,
+		"Notice":    `comment newline`,
+		"Directive": `"$" lowercase note? newline+  ! The lowercase names the scanner option.`,
+		"Import":    `"@" literal note? newline+  ! The literal is the name of a syntax file.`,
+		"Rule":      `uppercase ":" Definition newline+`,
 		"Definition": `
   - Multiline
+  - Precedence
   - Inline`,
 		"Multiline": `newline Line+`,
 		"Line":      `"-" Identifier note? newline`,
 		"Identifier": `
   - lowercase
   - uppercase`,
-		"Inline": `Term+ note?`,
+		"Precedence": `"%" Identifier note? newline Level+  ! The identifier names the operand.`,
+		"Level":      `associativity Operator+ note? newline`,
+		"Operator":   `literal`,
+		"Inline":     `Term+ note?`,
 		"Term": `
+  - Lookahead
   - Reference
   - literal`,
-		"Reference": `Identifier Cardinality?  ! The default cardinality is one.`,
+		"Lookahead": `predicate Term  ! The term is matched but never consumed.`,
+		"Reference": `Label? Identifier Cardinality? Separator?  ! The default cardinality is one.`,
+		"Label":     `lowercase &":" ":"  ! The label names the attribute for the reference.`,
+		"Separator": `"/" literal trailing:optional?  ! The optional separator may trail the instances.`,
 		"Cardinality": `
   - Constrained
   - Quantified`,
@@ -1532,7 +2604,9 @@ var syntax_ = col.Catalog[string, string](
   - repeated`,
 		"Quantified":  `"{" number Limit? "}"`,
 		"Limit":       `".." number?  ! The limit of a range of numbers is inclusive.`,
-		"Expression":  `lowercase ":" Pattern note? newline+`,
+		"Expression":  `lowercase ":" caseless? Pattern Transition? note? newline+`,
+		"Transition":  `"->" mode:lowercase?  ! Without a mode the enclosing mode is resumed.`,
+		"Mode":        `"[" lowercase "]" note? newline+ Expression+`,
 		"Pattern":     `Option Alternative*`,
 		"Alternative": `"|" Option`,
 		"Option":      `Repetition+`,
@@ -1555,3 +2629,25 @@ var syntax_ = col.Catalog[string, string](
   - lowercase`,
 	},
 )
+
+// The tokens that may begin each rule that the parser recovers from.
+var firsts_ = col.Catalog[string, []string](
+	map[string][]string{
+		"Directive":  {`"$"`},
+		"Import":     {`"@"`},
+		"Rule":       {"uppercase"},
+		"Expression": {"lowercase"},
+		"Mode":       {`"["`},
+	},
+)
+
+// The tokens that may follow each rule that the parser recovers from.
+var follows_ = col.Catalog[string, []string](
+	map[string][]string{
+		"Directive":  {`"$"`, `"@"`, "comment"},
+		"Import":     {`"@"`, "comment"},
+		"Rule":       {"comment", "uppercase"},
+		"Expression": {`"["`, "lowercase"},
+		"Mode":       {`"["`},
+	},
+)
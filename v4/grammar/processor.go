@@ -65,6 +65,12 @@ func (v *processor_) GetClass() ProcessorClassLike {
 
 // Methodical
 
+func (v *processor_) ProcessAssociativity(associativity string) {
+}
+
+func (v *processor_) ProcessCaseless(caseless string) {
+}
+
 func (v *processor_) ProcessComment(comment string) {
 }
 
@@ -99,6 +105,9 @@ func (v *processor_) ProcessNumber(number string) {
 func (v *processor_) ProcessOptional(optional string) {
 }
 
+func (v *processor_) ProcessPredicate(predicate string) {
+}
+
 func (v *processor_) ProcessRepeated(repeated string) {
 }
 
@@ -169,6 +178,23 @@ func (v *processor_) ProcessDefinitionSlot(slot uint) {
 func (v *processor_) PostprocessDefinition(definition ast.DefinitionLike) {
 }
 
+func (v *processor_) PreprocessDirective(
+	directive ast.DirectiveLike,
+	index uint,
+	size uint,
+) {
+}
+
+func (v *processor_) ProcessDirectiveSlot(slot uint) {
+}
+
+func (v *processor_) PostprocessDirective(
+	directive ast.DirectiveLike,
+	index uint,
+	size uint,
+) {
+}
+
 func (v *processor_) PreprocessElement(element ast.ElementLike) {
 }
 
@@ -240,6 +266,23 @@ func (v *processor_) ProcessIdentifierSlot(slot uint) {
 func (v *processor_) PostprocessIdentifier(identifier ast.IdentifierLike) {
 }
 
+func (v *processor_) PreprocessImport(
+	import_ ast.ImportLike,
+	index uint,
+	size uint,
+) {
+}
+
+func (v *processor_) ProcessImportSlot(slot uint) {
+}
+
+func (v *processor_) PostprocessImport(
+	import_ ast.ImportLike,
+	index uint,
+	size uint,
+) {
+}
+
 func (v *processor_) PreprocessInline(inline ast.InlineLike) {
 }
 
@@ -249,6 +292,32 @@ func (v *processor_) ProcessInlineSlot(slot uint) {
 func (v *processor_) PostprocessInline(inline ast.InlineLike) {
 }
 
+func (v *processor_) PreprocessLabel(label ast.LabelLike) {
+}
+
+func (v *processor_) ProcessLabelSlot(slot uint) {
+}
+
+func (v *processor_) PostprocessLabel(label ast.LabelLike) {
+}
+
+func (v *processor_) PreprocessLevel(
+	level ast.LevelLike,
+	index uint,
+	size uint,
+) {
+}
+
+func (v *processor_) ProcessLevelSlot(slot uint) {
+}
+
+func (v *processor_) PostprocessLevel(
+	level ast.LevelLike,
+	index uint,
+	size uint,
+) {
+}
+
 func (v *processor_) PreprocessLimit(limit ast.LimitLike) {
 }
 
@@ -275,6 +344,32 @@ func (v *processor_) PostprocessLine(
 ) {
 }
 
+func (v *processor_) PreprocessLookahead(lookahead ast.LookaheadLike) {
+}
+
+func (v *processor_) ProcessLookaheadSlot(slot uint) {
+}
+
+func (v *processor_) PostprocessLookahead(lookahead ast.LookaheadLike) {
+}
+
+func (v *processor_) PreprocessMode(
+	mode ast.ModeLike,
+	index uint,
+	size uint,
+) {
+}
+
+func (v *processor_) ProcessModeSlot(slot uint) {
+}
+
+func (v *processor_) PostprocessMode(
+	mode ast.ModeLike,
+	index uint,
+	size uint,
+) {
+}
+
 func (v *processor_) PreprocessMultiline(multiline ast.MultilineLike) {
 }
 
@@ -293,6 +388,23 @@ func (v *processor_) ProcessNoticeSlot(slot uint) {
 func (v *processor_) PostprocessNotice(notice ast.NoticeLike) {
 }
 
+func (v *processor_) PreprocessOperator(
+	operator ast.OperatorLike,
+	index uint,
+	size uint,
+) {
+}
+
+func (v *processor_) ProcessOperatorSlot(slot uint) {
+}
+
+func (v *processor_) PostprocessOperator(
+	operator ast.OperatorLike,
+	index uint,
+	size uint,
+) {
+}
+
 func (v *processor_) PreprocessOption(option ast.OptionLike) {
 }
 
@@ -311,6 +423,15 @@ func (v *processor_) ProcessPatternSlot(slot uint) {
 func (v *processor_) PostprocessPattern(pattern ast.PatternLike) {
 }
 
+func (v *processor_) PreprocessPrecedence(precedence ast.PrecedenceLike) {
+}
+
+func (v *processor_) ProcessPrecedenceSlot(slot uint) {
+}
+
+func (v *processor_) PostprocessPrecedence(precedence ast.PrecedenceLike) {
+}
+
 func (v *processor_) PreprocessQuantified(quantified ast.QuantifiedLike) {
 }
 
@@ -363,6 +484,15 @@ func (v *processor_) PostprocessRule(
 ) {
 }
 
+func (v *processor_) PreprocessSeparator(separator ast.SeparatorLike) {
+}
+
+func (v *processor_) ProcessSeparatorSlot(slot uint) {
+}
+
+func (v *processor_) PostprocessSeparator(separator ast.SeparatorLike) {
+}
+
 func (v *processor_) PreprocessSyntax(syntax ast.SyntaxLike) {
 }
 
@@ -397,3 +527,12 @@ func (v *processor_) ProcessTextSlot(slot uint) {
 
 func (v *processor_) PostprocessText(text ast.TextLike) {
 }
+
+func (v *processor_) PreprocessTransition(transition ast.TransitionLike) {
+}
+
+func (v *processor_) ProcessTransitionSlot(slot uint) {
+}
+
+func (v *processor_) PostprocessTransition(transition ast.TransitionLike) {
+}
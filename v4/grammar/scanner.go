@@ -17,7 +17,9 @@ import (
 	abs "github.com/craterdog/go-collection-framework/v4/collection"
 	reg "regexp"
 	sts "strings"
+	syn "sync"
 	uni "unicode"
+	utf "unicode/utf8"
 )
 
 // CLASS ACCESS
@@ -27,38 +29,45 @@ import (
 var scannerClass = &scannerClass_{
 	// Initialize the class constants.
 	tokens_: map[TokenType]string{
-		ErrorToken:     "error",
-		CommentToken:   "comment",
-		DelimiterToken: "delimiter",
-		ExcludedToken:  "excluded",
-		GlyphToken:     "glyph",
-		IntrinsicToken: "intrinsic",
-		LiteralToken:   "literal",
-		LowercaseToken: "lowercase",
-		NewlineToken:   "newline",
-		NoteToken:      "note",
-		NumberToken:    "number",
-		OptionalToken:  "optional",
-		RepeatedToken:  "repeated",
-		SpaceToken:     "space",
-		UppercaseToken: "uppercase",
+		ErrorToken:         "error",
+		AssociativityToken: "associativity",
+		CaselessToken:      "caseless",
+		CommentToken:       "comment",
+		DelimiterToken:     "delimiter",
+		ExcludedToken:      "excluded",
+		GlyphToken:         "glyph",
+		IntrinsicToken:     "intrinsic",
+		KeywordToken:       "keyword",
+		LiteralToken:       "literal",
+		LowercaseToken:     "lowercase",
+		NewlineToken:       "newline",
+		NoteToken:          "note",
+		NumberToken:        "number",
+		OptionalToken:      "optional",
+		PredicateToken:     "predicate",
+		RepeatedToken:      "repeated",
+		SpaceToken:         "space",
+		UppercaseToken:     "uppercase",
 	},
 	matchers_: map[TokenType]*reg.Regexp{
 		// Define pattern matchers for each type of token.
-		CommentToken:   reg.MustCompile("^" + comment_),
-		DelimiterToken: reg.MustCompile("^" + delimiter_),
-		ExcludedToken:  reg.MustCompile("^" + excluded_),
-		GlyphToken:     reg.MustCompile("^" + glyph_),
-		IntrinsicToken: reg.MustCompile("^" + intrinsic_),
-		LiteralToken:   reg.MustCompile("^" + literal_),
-		LowercaseToken: reg.MustCompile("^" + lowercase_),
-		NewlineToken:   reg.MustCompile("^" + newline_),
-		NoteToken:      reg.MustCompile("^" + note_),
-		NumberToken:    reg.MustCompile("^" + number_),
-		OptionalToken:  reg.MustCompile("^" + optional_),
-		RepeatedToken:  reg.MustCompile("^" + repeated_),
-		SpaceToken:     reg.MustCompile("^" + space_),
-		UppercaseToken: reg.MustCompile("^" + uppercase_),
+		AssociativityToken: reg.MustCompile("^" + associativity_),
+		CaselessToken:      reg.MustCompile("^" + caseless_),
+		CommentToken:       reg.MustCompile("^" + comment_),
+		DelimiterToken:     reg.MustCompile("^" + delimiter_),
+		ExcludedToken:      reg.MustCompile("^" + excluded_),
+		GlyphToken:         reg.MustCompile("^" + glyph_),
+		IntrinsicToken:     reg.MustCompile("^" + intrinsic_),
+		LiteralToken:       reg.MustCompile("^" + literal_),
+		LowercaseToken:     reg.MustCompile("^" + lowercase_),
+		NewlineToken:       reg.MustCompile("^" + newline_),
+		NoteToken:          reg.MustCompile("^" + note_),
+		NumberToken:        reg.MustCompile("^" + number_),
+		OptionalToken:      reg.MustCompile("^" + optional_),
+		PredicateToken:     reg.MustCompile("^" + predicate_),
+		RepeatedToken:      reg.MustCompile("^" + repeated_),
+		SpaceToken:         reg.MustCompile("^" + space_),
+		UppercaseToken:     reg.MustCompile("^" + uppercase_),
 	},
 }
 
@@ -89,8 +98,9 @@ func (c *scannerClass_) Make(
 		class_:    c,
 		line_:     1,
 		position_: 1,
-		runes_:    []rune(source),
+		source_:   source,
 		tokens_:   tokens,
+		closed_:   make(chan bool),
 	}
 	go scanner.scanTokens() // Start scanning tokens in the background.
 	return scanner
@@ -133,12 +143,14 @@ func (c *scannerClass_) MatchesType(
 type scanner_ struct {
 	// Define the instance attributes.
 	class_    *scannerClass_
-	first_    uint // A zero based index of the first possible rune in the next token.
-	next_     uint // A zero based index of the next possible rune in the next token.
+	first_    uint // A zero based index of the first possible byte in the next token.
+	next_     uint // A zero based index of the next possible byte in the next token.
 	line_     uint // The line number in the source string of the next rune.
 	position_ uint // The position in the current line of the next rune.
-	runes_    []rune
+	source_   string
 	tokens_   abs.QueueLike[TokenLike]
+	closing_  syn.Once  // This ensures that the scanner is only closed once.
+	closed_   chan bool // This is closed when the scanner should stop scanning.
 }
 
 // Public
@@ -147,6 +159,20 @@ func (v *scanner_) GetClass() ScannerClassLike {
 	return v.class_
 }
 
+func (v *scanner_) Close() {
+	// Only the first call closes the scanner, even when called concurrently.
+	v.closing_.Do(func() {
+		// Tell the background scanner to stop scanning.
+		close(v.closed_)
+
+		// Drain any unread tokens so that the background scanner is not blocked.
+		var ok = true
+		for ok {
+			_, ok = v.tokens_.RemoveHead() // This will wait for a token.
+		}
+	})
+}
+
 // Private
 
 /*
@@ -163,31 +189,39 @@ const (
 	control_ = "\\p{Cc}"
 	digit_   = "\\p{Nd}"
 	eol_     = "\\r?\\n"
+	letter_  = "\\p{L}"
 	lower_   = "\\p{Ll}"
+	punct_   = "\\p{P}"
+	symbol_  = "\\p{S}"
 	upper_   = "\\p{Lu}"
+	white_   = "\\t-\\r\\x85\\p{Z}" // This must be used within a character class.
 
 	// Define the regular expression patterns for each token type.
-	base16_    = "(?:[0-9a-f])"
-	comment_   = "(?:!>" + eol_ + "(" + any_ + "|" + eol_ + ")*?" + eol_ + "<!" + eol_ + ")"
-	delimiter_ = "(?:\\}|\\||\\{|\\]|\\[|\\.\\.|\\)|\\(|:|-)"
-	escape_    = "(?:\\\\((?:" + unicode_ + ")|[abfnrtv\"\\\\]))"
-	excluded_  = "(?:~)"
-	glyph_     = "(?:'[^" + control_ + "]')"
-	intrinsic_ = "(?:ANY|CONTROL|DIGIT|EOL|LOWER|UPPER)"
-	literal_   = "(?:\"((?:" + escape_ + ")|[^\"" + control_ + "])+\")"
-	lowercase_ = "(?:" + lower_ + "(" + digit_ + "|" + lower_ + "|" + upper_ + ")*)"
-	newline_   = "(?:" + eol_ + ")"
-	note_      = "(?:! [^" + control_ + "]*)"
-	number_    = "(?:" + digit_ + "+)"
-	optional_  = "(?:\\?)"
-	repeated_  = "(?:\\*|\\+)"
-	space_     = "(?:[ \\t]+)"
-	unicode_   = "(?:(x(?:" + base16_ + "){2})|(u(?:" + base16_ + "){4})|(U(?:" + base16_ + "){8}))"
-	uppercase_ = "(?:" + upper_ + "(" + digit_ + "|" + lower_ + "|" + upper_ + ")*)"
+	associativity_ = "(?:<|>|=)"
+	base16_        = "(?:[0-9a-f])"
+	caseless_      = "(?:\\(\\?i\\))"
+	comment_       = "(?:!>" + eol_ + "(" + any_ + "|" + eol_ + ")*?" + eol_ + "<!" + eol_ + ")"
+	delimiter_     = "(?:\\}|\\||\\{|\\]|\\[|\\.\\.|\\)|\\(|\\$|@|:|/|->|-|%)"
+	escape_        = "(?:\\\\((?:" + unicode_ + ")|[abfnrtv\"\\\\]))"
+	excluded_      = "(?:~)"
+	glyph_         = "(?:'[^" + control_ + "]')"
+	intrinsic_     = "(?:ANY|CONTROL|DIGIT|EOL|LETTER|LOWER|PUNCT|SPACE|SYMBOL|UPPER|(?:" + property_ + "))"
+	literal_       = "(?:\"((?:" + escape_ + ")|[^\"" + control_ + "])+\"i?)"
+	lowercase_     = "(?:" + lower_ + "(" + digit_ + "|" + lower_ + "|" + upper_ + ")*)"
+	newline_       = "(?:" + eol_ + ")"
+	note_          = "(?:! [^" + control_ + "]*)"
+	number_        = "(?:" + digit_ + "+)"
+	optional_      = "(?:\\?)"
+	predicate_     = "(?:&|!)"
+	property_      = "(?:UNICODE\\((" + lower_ + "|" + upper_ + "|_)+\\))"
+	repeated_      = "(?:\\*|\\+)"
+	space_         = "(?:[ \\t]+)"
+	unicode_       = "(?:(x(?:" + base16_ + "){2})|(u(?:" + base16_ + "){4})|(U(?:" + base16_ + "){8}))"
+	uppercase_     = "(?:" + upper_ + "(" + digit_ + "|" + lower_ + "|" + upper_ + ")*)"
 )
 
 func (v *scanner_) emitToken(tokenType TokenType) {
-	var value = string(v.runes_[v.first_:v.next_])
+	var value = v.source_[v.first_:v.next_]
 	switch value {
 	case "\x00":
 		value = "<NULL>"
@@ -204,31 +238,34 @@ func (v *scanner_) emitToken(tokenType TokenType) {
 	case "\v":
 		value = "<VTAB>"
 	}
-	var token = Token().Make(v.line_, v.position_, tokenType, value)
+	var token = Token().MakeWithOffset(v.line_, v.position_, v.first_, tokenType, value)
 	//fmt.Println(Scanner().FormatToken(token)) // Uncomment when debugging.
 	v.tokens_.AddValue(token) // This will block if the queue is full.
 }
 
 func (v *scanner_) foundError() {
-	v.next_++
+	var _, size = utf.DecodeRuneInString(v.source_[v.next_:])
+	v.next_ += uint(size)
 	v.emitToken(ErrorToken)
+	v.position_++
+	v.first_ = v.next_
 }
 
 func (v *scanner_) foundToken(tokenType TokenType) bool {
 	// Attempt to match the specified token type.
-	var text = string(v.runes_[v.next_:])
+	var text = v.source_[v.next_:] // Slicing a string does not copy it.
 	var matcher = scannerClass.matchers_[tokenType]
 	var match = matcher.FindString(text)
 	if len(match) == 0 {
 		return false
 	}
 
-	// Check for false delimiter matches.
-	var token = []rune(match)
-	var length = uint(len(token))
-	var previous = token[length-1]
-	if tokenType == DelimiterToken && uint(len(v.runes_)) > v.next_+length {
-		var next = v.runes_[v.next_+length]
+	// Check for false delimiter and keyword matches.
+	var length = uint(len(match))
+	var isDelimiter = tokenType == DelimiterToken || tokenType == KeywordToken
+	if isDelimiter && uint(len(v.source_)) > v.next_+length {
+		var previous, _ = utf.DecodeLastRuneInString(match)
+		var next, _ = utf.DecodeRuneInString(v.source_[v.next_+length:])
 		if (uni.IsLetter(previous) || uni.IsNumber(previous)) &&
 			(uni.IsLetter(next) || uni.IsNumber(next) || next == '_') {
 			return false
@@ -241,29 +278,37 @@ func (v *scanner_) foundToken(tokenType TokenType) bool {
 	var count = uint(sts.Count(match, "\n"))
 	if count > 0 {
 		v.line_ += count
-		v.position_ = v.indexOfLastEol(token)
+		v.position_ = v.indexOfLastEol(match)
 	} else {
-		v.position_ += v.next_ - v.first_
+		v.position_ += uint(utf.RuneCountInString(match))
 	}
 	v.first_ = v.next_
 	return true
 }
 
-func (v *scanner_) indexOfLastEol(runes []rune) uint {
-	var length = uint(len(runes))
-	for index := length; index > 0; index-- {
-		if runes[index-1] == '\n' {
-			return length - index + 1
-		}
+func (v *scanner_) indexOfLastEol(match string) uint {
+	var index = sts.LastIndex(match, "\n")
+	if index < 0 {
+		return 0
+	}
+	return uint(utf.RuneCountInString(match[index+1:])) + 1
+}
+
+func (v *scanner_) isClosed() bool {
+	select {
+	case <-v.closed_:
+		return true
+	default:
+		return false
 	}
-	return 0
 }
 
 func (v *scanner_) scanTokens() {
-loop:
-	for v.next_ < uint(len(v.runes_)) {
+	for v.next_ < uint(len(v.source_)) && !v.isClosed() {
 		switch {
 		// Find the next token type.
+		case v.foundToken(AssociativityToken):
+		case v.foundToken(CaselessToken):
 		case v.foundToken(CommentToken):
 		case v.foundToken(DelimiterToken):
 		case v.foundToken(ExcludedToken):
@@ -275,12 +320,13 @@ loop:
 		case v.foundToken(NoteToken):
 		case v.foundToken(NumberToken):
 		case v.foundToken(OptionalToken):
+		case v.foundToken(PredicateToken):
 		case v.foundToken(RepeatedToken):
 		case v.foundToken(SpaceToken):
 		case v.foundToken(UppercaseToken):
 		default:
+			// Skip the unrecognized character and keep scanning.
 			v.foundError()
-			break loop
 		}
 	}
 	v.tokens_.CloseQueue()
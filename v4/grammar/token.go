@@ -41,12 +41,23 @@ func (c *tokenClass_) Make(
 	position uint,
 	type_ TokenType,
 	value string,
+) TokenLike {
+	return c.MakeWithOffset(line, position, 0, type_, value)
+}
+
+func (c *tokenClass_) MakeWithOffset(
+	line uint,
+	position uint,
+	offset uint,
+	type_ TokenType,
+	value string,
 ) TokenLike {
 	return &token_{
 		// Initialize the instance attributes.
 		class_:    c,
 		line_:     line,
 		position_: position,
+		offset_:   offset,
 		type_:     type_,
 		value_:    value,
 	}
@@ -61,6 +72,7 @@ type token_ struct {
 	class_    *tokenClass_
 	line_     uint
 	position_ uint
+	offset_   uint // A zero based index of the first byte of the token in the source.
 	type_     TokenType
 	value_    string
 }
@@ -81,6 +93,10 @@ func (v *token_) GetPosition() uint {
 	return v.position_
 }
 
+func (v *token_) GetOffset() uint {
+	return v.offset_
+}
+
 func (v *token_) GetType() TokenType {
 	return v.type_
 }
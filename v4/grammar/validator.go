@@ -14,7 +14,12 @@ package grammar
 
 import (
 	fmt "fmt"
+	col "github.com/craterdog/go-collection-framework/v4"
+	abs "github.com/craterdog/go-collection-framework/v4/collection"
 	ast "github.com/craterdog/go-grammar-framework/v4/ast"
+	stc "strconv"
+	sts "strings"
+	uni "unicode"
 )
 
 // CLASS ACCESS
@@ -59,8 +64,18 @@ func (c *validatorClass_) Make() ValidatorLike {
 
 type validator_ struct {
 	// Define the instance attributes.
-	class_   *validatorClass_
-	visitor_ VisitorLike
+	class_       *validatorClass_
+	visitor_     VisitorLike
+	ruleName_    string                                        // The rule or expression being validated.
+	source_      string                                        // The file that defines it when imported.
+	definitions_ abs.SetLike[string]                           // The names of all rules and expressions.
+	references_  abs.CatalogLike[string, abs.ListLike[string]] // The names referenced by each definition.
+	problems_    abs.ListLike[string]                          // The semantic problems found so far.
+	rules_       abs.CatalogLike[string, ast.RuleLike]         // The first definition of each rule.
+	expressions_ abs.CatalogLike[string, ast.ExpressionLike]   // The first definition of each expression.
+	nullables_   abs.SetLike[string]                           // The definitions that may match nothing.
+	labels_      abs.SetLike[string]                           // The labels used by the current rule.
+	modes_       abs.SetLike[string]                           // The names of all scanner modes.
 
 	// Define the inherited aspects.
 	Methodical
@@ -92,6 +107,14 @@ func (v *validator_) ValidateSyntax(syntax ast.SyntaxLike) {
 
 // Methodical
 
+func (v *validator_) ProcessAssociativity(associativity string) {
+	v.ValidateToken(associativity, AssociativityToken)
+}
+
+func (v *validator_) ProcessCaseless(caseless string) {
+	v.ValidateToken(caseless, CaselessToken)
+}
+
 func (v *validator_) ProcessComment(comment string) {
 	v.ValidateToken(comment, CommentToken)
 }
@@ -136,6 +159,10 @@ func (v *validator_) ProcessOptional(optional string) {
 	v.ValidateToken(optional, OptionalToken)
 }
 
+func (v *validator_) ProcessPredicate(predicate string) {
+	v.ValidateToken(predicate, PredicateToken)
+}
+
 func (v *validator_) ProcessRepeated(repeated string) {
 	v.ValidateToken(repeated, RepeatedToken)
 }
@@ -148,11 +175,750 @@ func (v *validator_) ProcessUppercase(uppercase string) {
 	v.ValidateToken(uppercase, UppercaseToken)
 }
 
+func (v *validator_) PreprocessCharacter(
+	character ast.CharacterLike,
+	index uint,
+	size uint,
+) {
+	var intrinsic, ok = character.GetAny().(string)
+	if ok {
+		v.checkIntrinsic(intrinsic, character.GetSpan())
+	}
+}
+
+func (v *validator_) PreprocessDirective(
+	directive ast.DirectiveLike,
+	index uint,
+	size uint,
+) {
+	var name = directive.GetLowercase()
+	if !col.Set[string](scannerOptions_).ContainsValue(name) {
+		var message = fmt.Sprintf(
+			"The scanner option %q is not supported.",
+			name,
+		)
+		v.reportProblem(directive.GetSpan(), message)
+	}
+}
+
+func (v *validator_) PreprocessExpression(
+	expression ast.ExpressionLike,
+	index uint,
+	size uint,
+) {
+	v.ruleName_ = expression.GetLowercase()
+	v.source_ = v.getSource(expression.GetSpan())
+	v.references_.SetValue(v.ruleName_, col.List[string]())
+}
+
+func (v *validator_) PreprocessIdentifier(identifier ast.IdentifierLike) {
+	var name = identifier.GetAny().(string)
+	v.checkReference(name, identifier.GetSpan())
+}
+
+func (v *validator_) PreprocessImport(
+	import_ ast.ImportLike,
+	index uint,
+	size uint,
+) {
+	// The imported rules and expressions must be merged in before validation.
+	var message = fmt.Sprintf(
+		"The import of %v must be resolved before the syntax is validated.",
+		import_.GetLiteral(),
+	)
+	v.reportProblem(import_.GetSpan(), message)
+}
+
+func (v *validator_) PreprocessLabel(label ast.LabelLike) {
+	// Each label names a distinct attribute of the rule.
+	var name = label.GetLowercase()
+	if col.Set[string](reservedNames_).ContainsValue(name) {
+		var message = fmt.Sprintf(
+			"The label name %q is reserved for the generated AST classes.",
+			name,
+		)
+		v.reportProblem(label.GetSpan(), message)
+	}
+	if v.labels_.ContainsValue(name) {
+		var message = fmt.Sprintf(
+			"The label %q is used more than once in the %q rule.",
+			name,
+			v.ruleName_,
+		)
+		v.reportProblem(label.GetSpan(), message)
+	}
+	v.labels_.AddValue(name)
+}
+
+func (v *validator_) PreprocessLookahead(lookahead ast.LookaheadLike) {
+	// Only a single reference or literal may be looked ahead at.
+	switch actual := lookahead.GetTerm().GetAny().(type) {
+	case ast.LookaheadLike:
+		var message = "A lookahead predicate cannot be applied to another lookahead predicate."
+		v.reportProblem(lookahead.GetSpan(), message)
+	case ast.ReferenceLike:
+		if col.IsDefined(actual.GetOptionalCardinality()) {
+			var message = fmt.Sprintf(
+				"The lookahead predicate for %q cannot specify a cardinality.",
+				actual.GetIdentifier().GetAny().(string),
+			)
+			v.reportProblem(lookahead.GetSpan(), message)
+		}
+		if col.IsDefined(actual.GetOptionalLabel()) {
+			var message = fmt.Sprintf(
+				"The lookahead predicate for %q cannot specify a label.",
+				actual.GetIdentifier().GetAny().(string),
+			)
+			v.reportProblem(lookahead.GetSpan(), message)
+		}
+	}
+}
+
+func (v *validator_) PreprocessPrecedence(precedence ast.PrecedenceLike) {
+	// The operand is parsed before any operator so it must consume a token.
+	var operand = precedence.GetIdentifier().GetAny().(string)
+	if v.isNullableIdentifier(operand) {
+		var message = fmt.Sprintf(
+			"The operand %q of a precedence table may match nothing.",
+			operand,
+		)
+		v.reportProblem(precedence.GetSpan(), message)
+	}
+
+	// The binary operations of the table are represented by their own class.
+	var operation = v.ruleName_ + "Operation"
+	if v.definitions_.ContainsValue(operation) {
+		var message = fmt.Sprintf(
+			"The rule %q clashes with the operations of the %q precedence table.",
+			operation,
+			v.ruleName_,
+		)
+		v.reportProblem(precedence.GetSpan(), message)
+	}
+
+	// Each operator may appear on only one level of precedence.
+	var operators = col.Set[string]()
+	var levels = precedence.GetLevels().GetIterator()
+	for levels.HasNext() {
+		var iterator = levels.GetNext().GetOperators().GetIterator()
+		for iterator.HasNext() {
+			var operator = iterator.GetNext()
+			var literal = operator.GetLiteral()
+			if operators.ContainsValue(literal) {
+				var message = fmt.Sprintf(
+					"The operator %v appears more than once in the %q precedence table.",
+					literal,
+					v.ruleName_,
+				)
+				v.reportProblem(operator.GetSpan(), message)
+			}
+			operators.AddValue(literal)
+		}
+	}
+}
+
+func (v *validator_) PreprocessReference(reference ast.ReferenceLike) {
+	// A separator consumes a token between each of the instances.
+	var identifier = reference.GetIdentifier().GetAny().(string)
+	var separator = reference.GetOptionalSeparator()
+	if v.isUnlimited(reference.GetOptionalCardinality()) &&
+		col.IsUndefined(separator) &&
+		v.isNullableIdentifier(identifier) {
+		var message = fmt.Sprintf(
+			"The rule %q may match nothing so it cannot be repeated without a limit.",
+			identifier,
+		)
+		v.reportProblem(reference.GetSpan(), message)
+	}
+	if col.IsDefined(separator) && !v.allowsMany(reference.GetOptionalCardinality()) {
+		var message = fmt.Sprintf(
+			"The separator for %q requires a cardinality that allows more than one instance.",
+			identifier,
+		)
+		v.reportProblem(separator.GetSpan(), message)
+	}
+}
+
+func (v *validator_) PreprocessRepetition(
+	repetition ast.RepetitionLike,
+	index uint,
+	size uint,
+) {
+	var element = repetition.GetElement()
+	if v.isUnlimited(repetition.GetOptionalCardinality()) &&
+		v.isNullableElement(element) {
+		var message = "The group may match nothing so it cannot be repeated without a limit."
+		var text, ok = element.GetAny().(ast.TextLike)
+		if ok {
+			message = fmt.Sprintf(
+				"The expression %q may match nothing so it cannot be repeated without a limit.",
+				text.GetAny().(string),
+			)
+		}
+		v.reportProblem(repetition.GetSpan(), message)
+	}
+}
+
+func (v *validator_) PreprocessRule(
+	rule ast.RuleLike,
+	index uint,
+	size uint,
+) {
+	v.ruleName_ = rule.GetUppercase()
+	v.source_ = v.getSource(rule.GetSpan())
+	v.references_.SetValue(v.ruleName_, col.List[string]())
+	v.labels_ = col.Set[string]()
+}
+
 func (v *validator_) PreprocessSyntax(syntax ast.SyntaxLike) {
+	v.definitions_ = col.Set[string]()
+	v.references_ = col.Catalog[string, abs.ListLike[string]]()
+	v.problems_ = col.List[string]()
+	v.rules_ = col.Catalog[string, ast.RuleLike]()
+	v.expressions_ = col.Catalog[string, ast.ExpressionLike]()
+	v.modes_ = col.Set[string]()
+
+	// Define all rules and expressions before checking any references to them.
+	var rules = syntax.GetRules().GetIterator()
+	for rules.HasNext() {
+		var rule = rules.GetNext()
+		v.checkDefinition(rule.GetUppercase(), rule.GetSpan())
+		if col.IsUndefined(v.rules_.GetValue(rule.GetUppercase())) {
+			v.rules_.SetValue(rule.GetUppercase(), rule)
+		}
+	}
+	var modes = syntax.GetModes().GetIterator()
+	for modes.HasNext() {
+		var mode = modes.GetNext()
+		if v.modes_.ContainsValue(mode.GetLowercase()) {
+			var message = fmt.Sprintf(
+				"The mode %q is defined more than once.",
+				mode.GetLowercase(),
+			)
+			v.reportProblem(mode.GetSpan(), message)
+		}
+		v.modes_.AddValue(mode.GetLowercase())
+	}
+	var expressions = v.getExpressions(syntax).GetIterator()
+	for expressions.HasNext() {
+		var expression = expressions.GetNext()
+		v.checkDefinition(expression.GetLowercase(), expression.GetSpan())
+		if col.IsUndefined(v.expressions_.GetValue(expression.GetLowercase())) {
+			v.expressions_.SetValue(expression.GetLowercase(), expression)
+		}
+	}
+
+	// The intrinsic tokens need not be defined.
+	v.definitions_.AddValues(col.List[string](intrinsicTokens_))
+	var directives = syntax.GetDirectives().GetIterator()
+	for directives.HasNext() {
+		if directives.GetNext().GetLowercase() == "indentation" {
+			v.definitions_.AddValues(col.List[string](indentationTokens_))
+		}
+	}
+
+	// Repetitions are checked against the nullable definitions while visiting.
+	v.findNullables()
 }
 
 func (v *validator_) ProcessSyntaxSlot(slot uint) {
 }
 
 func (v *validator_) PostprocessSyntax(syntax ast.SyntaxLike) {
+	v.source_ = "" // The remaining problems are located by their own spans.
+	v.checkLeftRecursion()
+	v.checkReachability(syntax)
+	if !v.problems_.IsEmpty() {
+		var message = "The syntax contains the following problems:"
+		var problems = v.problems_.GetIterator()
+		for problems.HasNext() {
+			message += "\n  " + problems.GetNext()
+		}
+		panic(message)
+	}
+}
+
+func (v *validator_) PreprocessText(text ast.TextLike) {
+	var value = text.GetAny().(string)
+	switch {
+	case Scanner().MatchesType(value, LowercaseToken):
+		// Only lowercase text refers to another expression.
+		v.checkReference(value, text.GetSpan())
+	case Scanner().MatchesType(value, IntrinsicToken):
+		v.checkIntrinsic(value, text.GetSpan())
+	}
+}
+
+func (v *validator_) PreprocessTransition(transition ast.TransitionLike) {
+	var mode = transition.GetOptionalMode()
+	if len(mode) > 0 && !v.modes_.ContainsValue(mode) {
+		var message = fmt.Sprintf(
+			"The mode %q is not defined.",
+			mode,
+		)
+		v.reportProblem(transition.GetSpan(), message)
+	}
+}
+
+// Private
+
+func (v *validator_) allowsMany(cardinality ast.CardinalityLike) bool {
+	if col.IsUndefined(cardinality) {
+		return false
+	}
+	switch actual := cardinality.GetAny().(type) {
+	case ast.ConstrainedLike:
+		return actual.GetAny().(string) != "?"
+	case ast.QuantifiedLike:
+		var last = actual.GetNumber()
+		var limit = actual.GetOptionalLimit()
+		if col.IsDefined(limit) {
+			last = limit.GetOptionalNumber()
+			if col.IsUndefined(last) {
+				return true
+			}
+		}
+		return last != "0" && last != "1"
+	}
+	return false
+}
+
+func (v *validator_) allowsNone(cardinality ast.CardinalityLike) bool {
+	if col.IsUndefined(cardinality) {
+		return false
+	}
+	switch actual := cardinality.GetAny().(type) {
+	case ast.ConstrainedLike:
+		var constraint = actual.GetAny().(string)
+		return constraint == "?" || constraint == "*"
+	case ast.QuantifiedLike:
+		return actual.GetNumber() == "0"
+	}
+	return false
 }
+
+func (v *validator_) checkDefinition(name string, span ast.SpanLike) {
+	if col.Set[string](reservedNames_).ContainsValue(name) {
+		var message = fmt.Sprintf(
+			"The %v name %q is reserved for the generated AST classes.",
+			v.formatKind(name),
+			name,
+		)
+		v.reportProblem(span, message)
+	}
+	if v.definitions_.ContainsValue(name) {
+		var message = fmt.Sprintf(
+			"The %v %q is defined more than once.",
+			v.formatKind(name),
+			name,
+		)
+		v.reportProblem(span, message)
+	}
+	v.definitions_.AddValue(name)
+}
+
+func (v *validator_) checkIntrinsic(intrinsic string, span ast.SpanLike) {
+	if !sts.HasPrefix(intrinsic, "UNICODE(") {
+		return
+	}
+	var name = sts.TrimSuffix(sts.TrimPrefix(intrinsic, "UNICODE("), ")")
+	var _, isCategory = uni.Categories[name]
+	var _, isScript = uni.Scripts[name]
+	if !isCategory && !isScript {
+		var message = fmt.Sprintf(
+			"The unicode category or script %q is not defined.",
+			name,
+		)
+		v.reportProblem(span, message)
+	}
+}
+
+func (v *validator_) checkLeftRecursion() {
+	// Report each rule that can reach itself without consuming a token.
+	var reported = col.Set[string]()
+	var rules = v.rules_.GetIterator()
+	for rules.HasNext() {
+		var association = rules.GetNext()
+		var name = association.GetKey()
+		if reported.ContainsValue(name) {
+			continue
+		}
+		var cycle = v.findLeftCycle(name)
+		if col.IsUndefined(cycle) {
+			continue
+		}
+		reported.AddValues(cycle)
+		var message = fmt.Sprintf(
+			"The rule %q is left recursive: %v",
+			name,
+			sts.Join(cycle.AsArray(), " -> "),
+		)
+		v.reportProblem(association.GetValue().GetSpan(), message)
+	}
+}
+
+func (v *validator_) checkReachability(syntax ast.SyntaxLike) {
+	// Find all definitions that can be reached from the syntax rule.
+	var rules = syntax.GetRules().GetIterator()
+	if !rules.HasNext() {
+		return
+	}
+	var syntaxName = rules.GetNext().GetUppercase()
+	var reachable = col.Set[string](intrinsicTokens_)
+	var pending = col.List[string]([]string{syntaxName})
+	for !pending.IsEmpty() {
+		var name = pending.RemoveValue(1)
+		if reachable.ContainsValue(name) {
+			continue
+		}
+		reachable.AddValue(name)
+		var references = v.references_.GetValue(name)
+		if col.IsDefined(references) {
+			pending.AppendValues(references)
+		}
+	}
+
+	// Report any definitions that cannot be reached.
+	rules = syntax.GetRules().GetIterator()
+	for rules.HasNext() {
+		var rule = rules.GetNext()
+		v.checkReachable(reachable, rule.GetUppercase(), rule.GetSpan(), syntaxName)
+	}
+	var expressions = v.getExpressions(syntax).GetIterator()
+	for expressions.HasNext() {
+		var expression = expressions.GetNext()
+		v.checkReachable(reachable, expression.GetLowercase(), expression.GetSpan(), syntaxName)
+	}
+}
+
+func (v *validator_) checkReachable(
+	reachable abs.SetLike[string],
+	name string,
+	span ast.SpanLike,
+	syntaxName string,
+) {
+	if reachable.ContainsValue(name) || len(v.getSource(span)) > 0 {
+		// An imported syntax may define more than the importing syntax uses.
+		return
+	}
+	var message = fmt.Sprintf(
+		"The %v %q cannot be reached from the %q rule.",
+		v.formatKind(name),
+		name,
+		syntaxName,
+	)
+	v.reportProblem(span, message)
+}
+
+func (v *validator_) checkReference(name string, span ast.SpanLike) {
+	var references = v.references_.GetValue(v.ruleName_)
+	references.AppendValue(name)
+	if !v.definitions_.ContainsValue(name) {
+		var message = fmt.Sprintf(
+			"The %v %q is referenced but never defined.",
+			v.formatKind(name),
+			name,
+		)
+		v.reportProblem(span, message)
+	}
+}
+
+func (v *validator_) findLeftCycle(name string) abs.ListLike[string] {
+	// Search breadth first for the shortest path from the rule back to itself.
+	var previous = col.Catalog[string, string]()
+	var pending = col.List[string]([]string{name})
+	for !pending.IsEmpty() {
+		var current = pending.RemoveValue(1)
+		var leftmost = v.getLeftmost(current).GetIterator()
+		for leftmost.HasNext() {
+			var next = leftmost.GetNext()
+			if next == name {
+				// Follow the path back to the starting rule.
+				var cycle = col.List[string]([]string{next})
+				for current != name {
+					cycle.InsertValue(0, current)
+					current = previous.GetValue(current)
+				}
+				cycle.InsertValue(0, name)
+				return cycle
+			}
+			if len(previous.GetValue(next)) == 0 {
+				previous.SetValue(next, current)
+				pending.AppendValue(next)
+			}
+		}
+	}
+	return nil
+}
+
+func (v *validator_) findNullables() {
+	// Repeat until no more nullable rules or expressions are found.  The
+	// syntax need not be valid, any undefined rule or expression is treated as
+	// one that matches something.
+	v.nullables_ = col.Set[string]()
+	var found = true
+	for found {
+		found = false
+		var rules = v.rules_.GetIterator()
+		for rules.HasNext() {
+			var association = rules.GetNext()
+			var name = association.GetKey()
+			var definition = association.GetValue().GetDefinition()
+			if !v.nullables_.ContainsValue(name) && v.isNullableDefinition(definition) {
+				v.nullables_.AddValue(name)
+				found = true
+			}
+		}
+		var expressions = v.expressions_.GetIterator()
+		for expressions.HasNext() {
+			var association = expressions.GetNext()
+			var name = association.GetKey()
+			var pattern = association.GetValue().GetPattern()
+			if !v.nullables_.ContainsValue(name) && v.isNullablePattern(pattern) {
+				v.nullables_.AddValue(name)
+				found = true
+			}
+		}
+	}
+}
+
+func (v *validator_) formatKind(name string) string {
+	if Scanner().MatchesType(name, UppercaseToken) {
+		return "rule"
+	}
+	return "expression"
+}
+
+func (v *validator_) getExpressions(
+	syntax ast.SyntaxLike,
+) abs.Sequential[ast.ExpressionLike] {
+	// The expressions within each mode follow those in the default mode.
+	var expressions = col.List[ast.ExpressionLike](syntax.GetExpressions())
+	var modes = syntax.GetModes().GetIterator()
+	for modes.HasNext() {
+		expressions.AppendValues(modes.GetNext().GetExpressions())
+	}
+	return expressions
+}
+
+func (v *validator_) getLeftmost(name string) abs.Sequential[string] {
+	// Determine the rules that may be parsed before any token in the rule.
+	var leftmost = col.List[string]()
+	var rule = v.rules_.GetValue(name)
+	if col.IsUndefined(rule) {
+		return leftmost
+	}
+	switch actual := rule.GetDefinition().GetAny().(type) {
+	case ast.MultilineLike:
+		var lines = actual.GetLines().GetIterator()
+		for lines.HasNext() {
+			var identifier = lines.GetNext().GetIdentifier().GetAny().(string)
+			if Scanner().MatchesType(identifier, UppercaseToken) {
+				leftmost.AppendValue(identifier)
+			}
+		}
+	case ast.PrecedenceLike:
+		var identifier = actual.GetIdentifier().GetAny().(string)
+		if Scanner().MatchesType(identifier, UppercaseToken) {
+			leftmost.AppendValue(identifier)
+		}
+	case ast.InlineLike:
+		var terms = actual.GetTerms().GetIterator()
+		for terms.HasNext() {
+			var term = terms.GetNext()
+			var lookahead, ok = term.GetAny().(ast.LookaheadLike)
+			if ok {
+				// A lookahead parses its term without consuming any tokens.
+				term = lookahead.GetTerm()
+			}
+			var reference, isReference = term.GetAny().(ast.ReferenceLike)
+			if isReference {
+				var identifier = reference.GetIdentifier().GetAny().(string)
+				if Scanner().MatchesType(identifier, UppercaseToken) {
+					leftmost.AppendValue(identifier)
+				}
+			}
+			if !ok && !v.isNullableTerm(term) {
+				break
+			}
+		}
+	}
+	return leftmost
+}
+
+func (v *validator_) getSource(span ast.SpanLike) string {
+	// Only the definitions merged from an imported syntax name their source.
+	if col.IsUndefined(span) {
+		return ""
+	}
+	return span.GetSource()
+}
+
+func (v *validator_) isNullableDefinition(definition ast.DefinitionLike) bool {
+	switch actual := definition.GetAny().(type) {
+	case ast.MultilineLike:
+		// Any nullable alternative makes the rule nullable.
+		var lines = actual.GetLines().GetIterator()
+		for lines.HasNext() {
+			var identifier = lines.GetNext().GetIdentifier().GetAny().(string)
+			if v.isNullableIdentifier(identifier) {
+				return true
+			}
+		}
+		return false
+	case ast.PrecedenceLike:
+		// Every binary operation contains an operator so only the operand may
+		// match nothing.
+		var identifier = actual.GetIdentifier().GetAny().(string)
+		return v.isNullableIdentifier(identifier)
+	case ast.InlineLike:
+		// Every term must be nullable for the rule to be nullable.
+		var terms = actual.GetTerms().GetIterator()
+		for terms.HasNext() {
+			if !v.isNullableTerm(terms.GetNext()) {
+				return false
+			}
+		}
+		return true
+	}
+	return false
+}
+
+func (v *validator_) isNullableElement(element ast.ElementLike) bool {
+	switch actual := element.GetAny().(type) {
+	case ast.GroupLike:
+		return v.isNullablePattern(actual.GetPattern())
+	case ast.TextLike:
+		// Only a reference to a nullable expression may match nothing.
+		var text = actual.GetAny().(string)
+		return v.nullables_.ContainsValue(text)
+	}
+	// A filter always matches a single character.
+	return false
+}
+
+func (v *validator_) isNullableIdentifier(identifier string) bool {
+	// The scanner never produces an empty token so only rules may be nullable.
+	return Scanner().MatchesType(identifier, UppercaseToken) &&
+		v.nullables_.ContainsValue(identifier)
+}
+
+func (v *validator_) isNullableOption(option ast.OptionLike) bool {
+	// Every repetition must be nullable for the option to be nullable.
+	var repetitions = option.GetRepetitions().GetIterator()
+	for repetitions.HasNext() {
+		var repetition = repetitions.GetNext()
+		if !v.allowsNone(repetition.GetOptionalCardinality()) &&
+			!v.isNullableElement(repetition.GetElement()) {
+			return false
+		}
+	}
+	return true
+}
+
+func (v *validator_) isNullablePattern(pattern ast.PatternLike) bool {
+	// Any nullable option makes the pattern nullable.
+	if v.isNullableOption(pattern.GetOption()) {
+		return true
+	}
+	var alternatives = pattern.GetAlternatives().GetIterator()
+	for alternatives.HasNext() {
+		var alternative = alternatives.GetNext()
+		if v.isNullableOption(alternative.GetOption()) {
+			return true
+		}
+	}
+	return false
+}
+
+func (v *validator_) isNullableTerm(term ast.TermLike) bool {
+	switch actual := term.GetAny().(type) {
+	case ast.LookaheadLike:
+		// A lookahead never consumes a token.
+		return true
+	case ast.ReferenceLike:
+		if v.requiresSeparator(actual) {
+			// A separator always consumes a token.
+			return false
+		}
+		if v.allowsNone(actual.GetOptionalCardinality()) {
+			return true
+		}
+		var identifier = actual.GetIdentifier().GetAny().(string)
+		return v.isNullableIdentifier(identifier)
+	}
+	// A literal always consumes a token.
+	return false
+}
+
+func (v *validator_) isUnlimited(cardinality ast.CardinalityLike) bool {
+	if col.IsUndefined(cardinality) {
+		return false
+	}
+	switch actual := cardinality.GetAny().(type) {
+	case ast.ConstrainedLike:
+		var constraint = actual.GetAny().(string)
+		return constraint == "*" || constraint == "+"
+	case ast.QuantifiedLike:
+		var limit = actual.GetOptionalLimit()
+		return col.IsDefined(limit) && col.IsUndefined(limit.GetOptionalNumber())
+	}
+	return false
+}
+
+func (v *validator_) reportProblem(span ast.SpanLike, message string) {
+	var source = v.source_
+	if col.IsDefined(span) {
+		var start = span.GetStart()
+		message = fmt.Sprintf("%d:%d: ", start.GetLine(), start.GetColumn()) + message
+		if len(span.GetSource()) > 0 {
+			source = span.GetSource()
+		}
+	}
+	if len(source) > 0 {
+		// Positions within an imported file are prefixed with its path.
+		message = source + ":" + message
+	}
+	v.problems_.AppendValue(message)
+}
+
+func (v *validator_) requiresSeparator(reference ast.ReferenceLike) bool {
+	// A separator appears between the instances whenever at least two of them
+	// are required.
+	var cardinality = reference.GetOptionalCardinality()
+	if col.IsUndefined(reference.GetOptionalSeparator()) || col.IsUndefined(cardinality) {
+		return false
+	}
+	var quantified, ok = cardinality.GetAny().(ast.QuantifiedLike)
+	if !ok {
+		return false
+	}
+	var minimum, _ = stc.Atoi(quantified.GetNumber())
+	return minimum > 1
+}
+
+// PRIVATE GLOBALS
+
+// Constants
+
+/*
+These token types are always defined by the scanner so they need not be defined
+as expressions in the syntax.
+*/
+var intrinsicTokens_ = []string{"delimiter", "newline", "space"}
+
+/*
+These token types are also defined by the scanner when the "$indentation" scanner
+option is enabled.
+*/
+var indentationTokens_ = []string{"dedent", "indent"}
+
+/*
+These names are used by the AST classes that are always generated, the span of
+each node and the positions that delimit it, so they may not name a rule,
+expression or label.
+*/
+var reservedNames_ = []string{"Position", "Span", "span"}
+
+// These are the scanner options that may be enabled by a syntax.
+var scannerOptions_ = []string{"indentation"}
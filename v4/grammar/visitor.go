@@ -147,6 +147,10 @@ func (v *visitor_) visitDefinition(definition ast.DefinitionLike) {
 		v.processor_.PreprocessMultiline(actual)
 		v.visitMultiline(actual)
 		v.processor_.PostprocessMultiline(actual)
+	case ast.PrecedenceLike:
+		v.processor_.PreprocessPrecedence(actual)
+		v.visitPrecedence(actual)
+		v.processor_.PostprocessPrecedence(actual)
 	case ast.InlineLike:
 		v.processor_.PreprocessInline(actual)
 		v.visitInline(actual)
@@ -161,6 +165,38 @@ func (v *visitor_) visitDefinition(definition ast.DefinitionLike) {
 	}
 }
 
+func (v *visitor_) visitDirective(directive ast.DirectiveLike) {
+	// Visit the lowercase token.
+	var lowercase = directive.GetLowercase()
+	v.processor_.ProcessLowercase(lowercase)
+
+	// Visit slot 1 between references.
+	v.processor_.ProcessDirectiveSlot(1)
+
+	// Visit the optional note token.
+	var optionalNote = directive.GetOptionalNote()
+	if col.IsDefined(optionalNote) {
+		v.processor_.ProcessNote(optionalNote)
+	}
+
+	// Visit slot 2 between references.
+	v.processor_.ProcessDirectiveSlot(2)
+
+	// Visit each newline token.
+	var newlineIndex uint
+	var newlines = directive.GetNewlines().GetIterator()
+	var newlinesSize = uint(newlines.GetSize())
+	for newlines.HasNext() {
+		newlineIndex++
+		var newline = newlines.GetNext()
+		v.processor_.ProcessNewline(
+			newline,
+			newlineIndex,
+			newlinesSize,
+		)
+	}
+}
+
 func (v *visitor_) visitElement(element ast.ElementLike) {
 	// Visit the possible element types.
 	switch actual := element.GetAny().(type) {
@@ -211,14 +247,34 @@ func (v *visitor_) visitExpression(expression ast.ExpressionLike) {
 	// Visit slot 1 between references.
 	v.processor_.ProcessExpressionSlot(1)
 
+	// Visit the optional caseless token.
+	var optionalCaseless = expression.GetOptionalCaseless()
+	if col.IsDefined(optionalCaseless) {
+		v.processor_.ProcessCaseless(optionalCaseless)
+	}
+
+	// Visit slot 2 between references.
+	v.processor_.ProcessExpressionSlot(2)
+
 	// Visit the pattern rule.
 	var pattern = expression.GetPattern()
 	v.processor_.PreprocessPattern(pattern)
 	v.visitPattern(pattern)
 	v.processor_.PostprocessPattern(pattern)
 
-	// Visit slot 2 between references.
-	v.processor_.ProcessExpressionSlot(2)
+	// Visit slot 3 between references.
+	v.processor_.ProcessExpressionSlot(3)
+
+	// Visit the optional transition rule.
+	var optionalTransition = expression.GetOptionalTransition()
+	if col.IsDefined(optionalTransition) {
+		v.processor_.PreprocessTransition(optionalTransition)
+		v.visitTransition(optionalTransition)
+		v.processor_.PostprocessTransition(optionalTransition)
+	}
+
+	// Visit slot 4 between references.
+	v.processor_.ProcessExpressionSlot(4)
 
 	// Visit the optional note token.
 	var optionalNote = expression.GetOptionalNote()
@@ -226,8 +282,8 @@ func (v *visitor_) visitExpression(expression ast.ExpressionLike) {
 		v.processor_.ProcessNote(optionalNote)
 	}
 
-	// Visit slot 3 between references.
-	v.processor_.ProcessExpressionSlot(3)
+	// Visit slot 5 between references.
+	v.processor_.ProcessExpressionSlot(5)
 
 	// Visit each newline token.
 	var newlineIndex uint
@@ -306,6 +362,38 @@ func (v *visitor_) visitIdentifier(identifier ast.IdentifierLike) {
 	}
 }
 
+func (v *visitor_) visitImport(import_ ast.ImportLike) {
+	// Visit the literal token.
+	var literal = import_.GetLiteral()
+	v.processor_.ProcessLiteral(literal)
+
+	// Visit slot 1 between references.
+	v.processor_.ProcessImportSlot(1)
+
+	// Visit the optional note token.
+	var optionalNote = import_.GetOptionalNote()
+	if col.IsDefined(optionalNote) {
+		v.processor_.ProcessNote(optionalNote)
+	}
+
+	// Visit slot 2 between references.
+	v.processor_.ProcessImportSlot(2)
+
+	// Visit each newline token.
+	var newlineIndex uint
+	var newlines = import_.GetNewlines().GetIterator()
+	var newlinesSize = uint(newlines.GetSize())
+	for newlines.HasNext() {
+		newlineIndex++
+		var newline = newlines.GetNext()
+		v.processor_.ProcessNewline(
+			newline,
+			newlineIndex,
+			newlinesSize,
+		)
+	}
+}
+
 func (v *visitor_) visitInline(inline ast.InlineLike) {
 	// Visit each term rule.
 	var termIndex uint
@@ -337,6 +425,59 @@ func (v *visitor_) visitInline(inline ast.InlineLike) {
 	}
 }
 
+func (v *visitor_) visitLabel(label ast.LabelLike) {
+	// Visit the lowercase token.
+	var lowercase = label.GetLowercase()
+	v.processor_.ProcessLowercase(lowercase)
+}
+
+func (v *visitor_) visitLevel(level ast.LevelLike) {
+	// Visit the associativity token.
+	var associativity = level.GetAssociativity()
+	v.processor_.ProcessAssociativity(associativity)
+
+	// Visit slot 1 between references.
+	v.processor_.ProcessLevelSlot(1)
+
+	// Visit each operator rule.
+	var operatorIndex uint
+	var operators = level.GetOperators().GetIterator()
+	var operatorsSize = uint(operators.GetSize())
+	for operators.HasNext() {
+		operatorIndex++
+		var operator = operators.GetNext()
+		v.processor_.PreprocessOperator(
+			operator,
+			operatorIndex,
+			operatorsSize,
+		)
+		v.visitOperator(operator)
+		v.processor_.PostprocessOperator(
+			operator,
+			operatorIndex,
+			operatorsSize,
+		)
+	}
+
+	// Visit slot 2 between references.
+	v.processor_.ProcessLevelSlot(2)
+
+	// Visit the optional note token.
+	var optionalNote = level.GetOptionalNote()
+	if col.IsDefined(optionalNote) {
+		v.processor_.ProcessNote(optionalNote)
+	}
+
+	// Visit slot 3 between references.
+	v.processor_.ProcessLevelSlot(3)
+
+	// Visit the newline token.
+	var newline = level.GetNewline()
+	if col.IsDefined(newline) {
+		v.processor_.ProcessNewline(newline, 1, 1)
+	}
+}
+
 func (v *visitor_) visitLimit(limit ast.LimitLike) {
 	// Visit the optional number token.
 	var optionalNumber = limit.GetOptionalNumber()
@@ -371,6 +512,78 @@ func (v *visitor_) visitLine(line ast.LineLike) {
 	}
 }
 
+func (v *visitor_) visitLookahead(lookahead ast.LookaheadLike) {
+	// Visit the predicate token.
+	var predicate = lookahead.GetPredicate()
+	v.processor_.ProcessPredicate(predicate)
+
+	// Visit slot 1 between references.
+	v.processor_.ProcessLookaheadSlot(1)
+
+	// Visit the term rule.
+	var term = lookahead.GetTerm()
+	if col.IsDefined(term) {
+		v.processor_.PreprocessTerm(term, 1, 1)
+		v.visitTerm(term)
+		v.processor_.PostprocessTerm(term, 1, 1)
+	}
+}
+
+func (v *visitor_) visitMode(mode ast.ModeLike) {
+	// Visit the lowercase token.
+	var lowercase = mode.GetLowercase()
+	v.processor_.ProcessLowercase(lowercase)
+
+	// Visit slot 1 between references.
+	v.processor_.ProcessModeSlot(1)
+
+	// Visit the optional note token.
+	var optionalNote = mode.GetOptionalNote()
+	if col.IsDefined(optionalNote) {
+		v.processor_.ProcessNote(optionalNote)
+	}
+
+	// Visit slot 2 between references.
+	v.processor_.ProcessModeSlot(2)
+
+	// Visit each newline token.
+	var newlineIndex uint
+	var newlines = mode.GetNewlines().GetIterator()
+	var newlinesSize = uint(newlines.GetSize())
+	for newlines.HasNext() {
+		newlineIndex++
+		var newline = newlines.GetNext()
+		v.processor_.ProcessNewline(
+			newline,
+			newlineIndex,
+			newlinesSize,
+		)
+	}
+
+	// Visit slot 3 between references.
+	v.processor_.ProcessModeSlot(3)
+
+	// Visit each expression rule.
+	var expressionIndex uint
+	var expressions = mode.GetExpressions().GetIterator()
+	var expressionsSize = uint(expressions.GetSize())
+	for expressions.HasNext() {
+		expressionIndex++
+		var expression = expressions.GetNext()
+		v.processor_.PreprocessExpression(
+			expression,
+			expressionIndex,
+			expressionsSize,
+		)
+		v.visitExpression(expression)
+		v.processor_.PostprocessExpression(
+			expression,
+			expressionIndex,
+			expressionsSize,
+		)
+	}
+}
+
 func (v *visitor_) visitMultiline(multiline ast.MultilineLike) {
 	// Visit the newline token.
 	var newline = multiline.GetNewline()
@@ -417,6 +630,12 @@ func (v *visitor_) visitNotice(notice ast.NoticeLike) {
 	}
 }
 
+func (v *visitor_) visitOperator(operator ast.OperatorLike) {
+	// Visit the literal token.
+	var literal = operator.GetLiteral()
+	v.processor_.ProcessLiteral(literal)
+}
+
 func (v *visitor_) visitOption(option ast.OptionLike) {
 	// Visit each repetition rule.
 	var repetitionIndex uint
@@ -470,6 +689,55 @@ func (v *visitor_) visitPattern(pattern ast.PatternLike) {
 	}
 }
 
+func (v *visitor_) visitPrecedence(precedence ast.PrecedenceLike) {
+	// Visit the identifier rule.
+	var identifier = precedence.GetIdentifier()
+	v.processor_.PreprocessIdentifier(identifier)
+	v.visitIdentifier(identifier)
+	v.processor_.PostprocessIdentifier(identifier)
+
+	// Visit slot 1 between references.
+	v.processor_.ProcessPrecedenceSlot(1)
+
+	// Visit the optional note token.
+	var optionalNote = precedence.GetOptionalNote()
+	if col.IsDefined(optionalNote) {
+		v.processor_.ProcessNote(optionalNote)
+	}
+
+	// Visit slot 2 between references.
+	v.processor_.ProcessPrecedenceSlot(2)
+
+	// Visit the newline token.
+	var newline = precedence.GetNewline()
+	if col.IsDefined(newline) {
+		v.processor_.ProcessNewline(newline, 1, 1)
+	}
+
+	// Visit slot 3 between references.
+	v.processor_.ProcessPrecedenceSlot(3)
+
+	// Visit each level rule.
+	var levelIndex uint
+	var levels = precedence.GetLevels().GetIterator()
+	var levelsSize = uint(levels.GetSize())
+	for levels.HasNext() {
+		levelIndex++
+		var level = levels.GetNext()
+		v.processor_.PreprocessLevel(
+			level,
+			levelIndex,
+			levelsSize,
+		)
+		v.visitLevel(level)
+		v.processor_.PostprocessLevel(
+			level,
+			levelIndex,
+			levelsSize,
+		)
+	}
+}
+
 func (v *visitor_) visitQuantified(quantified ast.QuantifiedLike) {
 	// Visit the number token.
 	var number = quantified.GetNumber()
@@ -488,14 +756,25 @@ func (v *visitor_) visitQuantified(quantified ast.QuantifiedLike) {
 }
 
 func (v *visitor_) visitReference(reference ast.ReferenceLike) {
+	// Visit the optional label rule.
+	var optionalLabel = reference.GetOptionalLabel()
+	if col.IsDefined(optionalLabel) {
+		v.processor_.PreprocessLabel(optionalLabel)
+		v.visitLabel(optionalLabel)
+		v.processor_.PostprocessLabel(optionalLabel)
+	}
+
+	// Visit slot 1 between references.
+	v.processor_.ProcessReferenceSlot(1)
+
 	// Visit the identifier rule.
 	var identifier = reference.GetIdentifier()
 	v.processor_.PreprocessIdentifier(identifier)
 	v.visitIdentifier(identifier)
 	v.processor_.PostprocessIdentifier(identifier)
 
-	// Visit slot 1 between references.
-	v.processor_.ProcessReferenceSlot(1)
+	// Visit slot 2 between references.
+	v.processor_.ProcessReferenceSlot(2)
 
 	// Visit the optional cardinality rule.
 	var optionalCardinality = reference.GetOptionalCardinality()
@@ -504,6 +783,17 @@ func (v *visitor_) visitReference(reference ast.ReferenceLike) {
 		v.visitCardinality(optionalCardinality)
 		v.processor_.PostprocessCardinality(optionalCardinality)
 	}
+
+	// Visit slot 3 between references.
+	v.processor_.ProcessReferenceSlot(3)
+
+	// Visit the optional separator rule.
+	var optionalSeparator = reference.GetOptionalSeparator()
+	if col.IsDefined(optionalSeparator) {
+		v.processor_.PreprocessSeparator(optionalSeparator)
+		v.visitSeparator(optionalSeparator)
+		v.processor_.PostprocessSeparator(optionalSeparator)
+	}
 }
 
 func (v *visitor_) visitRepetition(repetition ast.RepetitionLike) {
@@ -557,6 +847,21 @@ func (v *visitor_) visitRule(rule ast.RuleLike) {
 	}
 }
 
+func (v *visitor_) visitSeparator(separator ast.SeparatorLike) {
+	// Visit the literal token.
+	var literal = separator.GetLiteral()
+	v.processor_.ProcessLiteral(literal)
+
+	// Visit slot 1 between references.
+	v.processor_.ProcessSeparatorSlot(1)
+
+	// Visit the optional optional token.
+	var optionalTrailing = separator.GetOptionalTrailing()
+	if col.IsDefined(optionalTrailing) {
+		v.processor_.ProcessOptional(optionalTrailing)
+	}
+}
+
 func (v *visitor_) visitSyntax(syntax ast.SyntaxLike) {
 	// Visit the notice rule.
 	var notice = syntax.GetNotice()
@@ -567,13 +872,68 @@ func (v *visitor_) visitSyntax(syntax ast.SyntaxLike) {
 	// Visit slot 1 between references.
 	v.processor_.ProcessSyntaxSlot(1)
 
-	// Visit the comment token.
-	var comment1 = syntax.GetComment1()
-	v.processor_.ProcessComment(comment1)
+	// Visit each directive rule.
+	var directiveIndex uint
+	var directives = syntax.GetDirectives().GetIterator()
+	var directivesSize = uint(directives.GetSize())
+	for directives.HasNext() {
+		directiveIndex++
+		var directive = directives.GetNext()
+		v.processor_.PreprocessDirective(
+			directive,
+			directiveIndex,
+			directivesSize,
+		)
+		v.visitDirective(directive)
+		v.processor_.PostprocessDirective(
+			directive,
+			directiveIndex,
+			directivesSize,
+		)
+	}
 
 	// Visit slot 2 between references.
 	v.processor_.ProcessSyntaxSlot(2)
 
+	// Visit each import rule.
+	var importIndex uint
+	var imports = syntax.GetImports().GetIterator()
+	var importsSize = uint(imports.GetSize())
+	for imports.HasNext() {
+		importIndex++
+		var import_ = imports.GetNext()
+		v.processor_.PreprocessImport(
+			import_,
+			importIndex,
+			importsSize,
+		)
+		v.visitImport(import_)
+		v.processor_.PostprocessImport(
+			import_,
+			importIndex,
+			importsSize,
+		)
+	}
+
+	// Visit slot 3 between references.
+	v.processor_.ProcessSyntaxSlot(3)
+
+	// Visit the comment token.
+	var ruleHeader = syntax.GetRuleHeader()
+	v.processor_.ProcessComment(ruleHeader)
+
+	// Visit slot 4 between references.
+	v.processor_.ProcessSyntaxSlot(4)
+
+	// Visit the newline token.
+	var optionalNewline = syntax.GetOptionalNewline()
+	if col.IsDefined(optionalNewline) {
+		v.processor_.ProcessNewline(optionalNewline, 1, 1)
+	}
+
+	// Visit slot 5 between references.
+	v.processor_.ProcessSyntaxSlot(5)
+
 	// Visit each rule rule.
 	var ruleIndex uint
 	var rules = syntax.GetRules().GetIterator()
@@ -594,15 +954,15 @@ func (v *visitor_) visitSyntax(syntax ast.SyntaxLike) {
 		)
 	}
 
-	// Visit slot 3 between references.
-	v.processor_.ProcessSyntaxSlot(3)
+	// Visit slot 6 between references.
+	v.processor_.ProcessSyntaxSlot(6)
 
 	// Visit the comment token.
-	var comment2 = syntax.GetComment2()
-	v.processor_.ProcessComment(comment2)
+	var expressionHeader = syntax.GetExpressionHeader()
+	v.processor_.ProcessComment(expressionHeader)
 
-	// Visit slot 4 between references.
-	v.processor_.ProcessSyntaxSlot(4)
+	// Visit slot 7 between references.
+	v.processor_.ProcessSyntaxSlot(7)
 
 	// Visit each expression rule.
 	var expressionIndex uint
@@ -623,11 +983,38 @@ func (v *visitor_) visitSyntax(syntax ast.SyntaxLike) {
 			expressionsSize,
 		)
 	}
+
+	// Visit slot 8 between references.
+	v.processor_.ProcessSyntaxSlot(8)
+
+	// Visit each mode rule.
+	var modeIndex uint
+	var modes = syntax.GetModes().GetIterator()
+	var modesSize = uint(modes.GetSize())
+	for modes.HasNext() {
+		modeIndex++
+		var mode = modes.GetNext()
+		v.processor_.PreprocessMode(
+			mode,
+			modeIndex,
+			modesSize,
+		)
+		v.visitMode(mode)
+		v.processor_.PostprocessMode(
+			mode,
+			modeIndex,
+			modesSize,
+		)
+	}
 }
 
 func (v *visitor_) visitTerm(term ast.TermLike) {
 	// Visit the possible term types.
 	switch actual := term.GetAny().(type) {
+	case ast.LookaheadLike:
+		v.processor_.PreprocessLookahead(actual)
+		v.visitLookahead(actual)
+		v.processor_.PostprocessLookahead(actual)
 	case ast.ReferenceLike:
 		v.processor_.PreprocessReference(actual)
 		v.visitReference(actual)
@@ -664,3 +1051,11 @@ func (v *visitor_) visitText(text ast.TextLike) {
 		panic(fmt.Sprintf("Invalid rule type: %T", actual))
 	}
 }
+
+func (v *visitor_) visitTransition(transition ast.TransitionLike) {
+	// Visit the optional lowercase token.
+	var optionalMode = transition.GetOptionalMode()
+	if col.IsDefined(optionalMode) {
+		v.processor_.ProcessLowercase(optionalMode)
+	}
+}
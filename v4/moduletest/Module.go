@@ -0,0 +1,65 @@
+/*
+................................................................................
+.    Copyright (c) 2009-2024 Crater Dog Technologies.  All Rights Reserved.    .
+................................................................................
+.  DO NOT ALTER OR REMOVE COPYRIGHT NOTICES OR THIS FILE HEADER.               .
+.                                                                              .
+.  This code is free software; you can redistribute it and/or modify it under  .
+.  the terms of The MIT License (MIT), as published by the Open Source         .
+.  Initiative. (See https://opensource.org/license/MIT)                        .
+................................................................................
+*/
+
+/*
+Package "moduletest" defines the functions that test a module generated from a
+syntax.  They are kept out of package "module" so that only test binaries depend
+on the "testing" package.
+
+For detailed documentation on this entire module refer to the wiki:
+  - https://github.com/craterdog/go-grammar-framework/wiki
+*/
+package moduletest
+
+import (
+	gra "github.com/craterdog/go-grammar-framework/v4"
+	fil "path/filepath"
+	tes "testing"
+)
+
+// Testing
+
+/*
+VerifyModule fails the specified test for each file in the module directory
+that differs from what would be generated for the syntax, reporting a unified
+diff for the file.  Any files that are maintained by hand may be ignored by
+listing their paths relative to the module directory.  It is intended to be
+called from a test in the generated module, e.g.
+
+	func TestGeneratedCode(t *testing.T) {
+		var syntax = gra.ParseSource(source)
+		mdt.VerifyModule(t, ".", module, wiki, syntax)
+	}
+*/
+func VerifyModule(
+	t tes.TB,
+	directory string,
+	module string,
+	wiki string,
+	syntax gra.SyntaxLike,
+	ignored ...string,
+) {
+	t.Helper()
+	var differences = gra.CheckModule(directory, module, wiki, syntax)
+	for _, path := range ignored {
+		differences.RemoveValue(fil.Join(directory, path))
+	}
+	var iterator = differences.GetIterator()
+	for iterator.HasNext() {
+		var association = iterator.GetNext()
+		t.Errorf(
+			"The generated file %v is stale, regenerate it from the syntax:\n%v",
+			association.GetKey(),
+			association.GetValue(),
+		)
+	}
+}